@@ -2,8 +2,11 @@ package container
 
 import (
 	"github.com/fe3dback/go-arch-lint/internal/services/checker"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/baseline"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/diff"
 	"github.com/fe3dback/go-arch-lint/internal/services/common/path"
 	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/reference"
+	"github.com/fe3dback/go-arch-lint/internal/services/preset"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/holder"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/info"
 	"github.com/fe3dback/go-arch-lint/internal/services/project/resolver"
@@ -12,6 +15,9 @@ import (
 	"github.com/fe3dback/go-arch-lint/internal/services/schema"
 	specassembler "github.com/fe3dback/go-arch-lint/internal/services/spec/assembler"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec/decoder"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/formatter"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/inspector"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec/migrator"
 	specvalidator "github.com/fe3dback/go-arch-lint/internal/services/spec/validator"
 )
 
@@ -33,13 +39,35 @@ func (c *Container) provideYamlSpecProvider() *decoder.Decoder {
 	return decoder.NewDecoder(
 		c.provideSourceCodeReferenceResolver(),
 		c.provideJsonSchemaProvider(),
+		c.providePresetProvider(),
 	)
 }
 
+func (c *Container) provideSpecInspector() *inspector.Inspector {
+	return inspector.NewInspector(
+		c.provideYamlSpecProvider(),
+		c.provideProjectFilesResolver(),
+	)
+}
+
+func (c *Container) provideSpecMigrator() *migrator.Migrator {
+	return migrator.NewMigrator(
+		c.provideYamlSpecProvider(),
+	)
+}
+
+func (c *Container) provideSpecFormatter() *formatter.Formatter {
+	return formatter.NewFormatter()
+}
+
 func (c *Container) providePathResolver() *path.Resolver {
 	return path.NewResolver()
 }
 
+func (c *Container) provideDiffer() *diff.Differ {
+	return diff.NewDiffer()
+}
+
 func (c *Container) provideSourceCodeReferenceResolver() *reference.Resolver {
 	return reference.NewResolver()
 }
@@ -52,17 +80,45 @@ func (c *Container) provideReferenceRender() *code.Render {
 
 func (c *Container) provideSpecChecker() *checker.CompositeChecker {
 	return checker.NewCompositeChecker(
+		c.provideProjectFilesResolver(),
 		c.provideSpecImportsChecker(),
+		c.provideSpecCapabilitiesChecker(),
+		c.provideSpecSymbolsChecker(),
+		c.provideSpecCyclesChecker(),
 		c.provideSpecDeepScanChecker(),
+		c.provideSpecLeaksChecker(),
 	)
 }
 
+func (c *Container) provideBaseline() *baseline.Baseline {
+	return baseline.NewBaseline()
+}
+
 func (c *Container) provideSpecImportsChecker() *checker.Imports {
 	return checker.NewImport(
 		c.provideProjectFilesResolver(),
 	)
 }
 
+func (c *Container) provideSpecCapabilitiesChecker() *checker.Capabilities {
+	return checker.NewCapabilities(
+		c.provideProjectFilesResolver(),
+	)
+}
+
+func (c *Container) provideSpecSymbolsChecker() *checker.Symbols {
+	return checker.NewSymbols(
+		c.provideProjectFilesResolver(),
+		c.provideReferenceRender(),
+	)
+}
+
+func (c *Container) provideSpecCyclesChecker() *checker.Cycles {
+	return checker.NewCycles(
+		c.provideProjectFilesResolver(),
+	)
+}
+
 func (c *Container) provideSpecDeepScanChecker() *checker.DeepScan {
 	return checker.NewDeepScan(
 		c.provideProjectFilesResolver(),
@@ -70,6 +126,13 @@ func (c *Container) provideSpecDeepScanChecker() *checker.DeepScan {
 	)
 }
 
+func (c *Container) provideSpecLeaksChecker() *checker.Leaks {
+	return checker.NewLeaks(
+		c.provideProjectFilesResolver(),
+		c.provideReferenceRender(),
+	)
+}
+
 func (c *Container) provideProjectFilesResolver() *resolver.Resolver {
 	return resolver.NewResolver(
 		c.provideProjectFilesScanner(),
@@ -92,3 +155,7 @@ func (c *Container) provideProjectInfoAssembler() *info.Assembler {
 func (c *Container) provideJsonSchemaProvider() *schema.Provider {
 	return schema.NewProvider()
 }
+
+func (c *Container) providePresetProvider() *preset.Provider {
+	return preset.NewProvider()
+}
@@ -111,6 +111,9 @@ func (c *Container) commands() []*cobra.Command {
 		unwrap(c.commandCheck()),
 		unwrap(c.commandMapping()),
 		unwrap(c.commandGraph()),
+		unwrap(c.commandInit()),
+		unwrap(c.commandMigrate()),
+		unwrap(c.commandFmt()),
 	}
 
 	list := make([]*cobra.Command, 0, len(executors))
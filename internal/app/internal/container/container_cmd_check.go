@@ -25,6 +25,9 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
 	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
 	cmd.PersistentFlags().IntVar(&in.MaxWarnings, "max-warnings", in.MaxWarnings, "max number of warnings to output")
+	cmd.PersistentFlags().BoolVar(&in.Strict, "strict", in.Strict, "fail check, when some package matched by several components")
+	cmd.PersistentFlags().StringVar(&in.Baseline, "baseline", in.Baseline, "baseline file path, only warnings not found in baseline will fail check")
+	cmd.PersistentFlags().StringVar(&in.BaselineWrite, "baseline-write", in.BaselineWrite, "write all current warnings into baseline file")
 
 	return cmd, func(act *cobra.Command) (any, error) {
 		const warningsRangeMin = 1
@@ -39,6 +42,10 @@ func (c *Container) commandCheck() (*cobra.Command, runner) {
 			)
 		}
 
+		if in.Baseline != "" && in.BaselineWrite != "" {
+			return nil, fmt.Errorf("flags '%s' and '%s' can't be used together", "baseline", "baseline-write")
+		}
+
 		return c.commandCheckOperation().Behave(act.Context(), in)
 	}
 }
@@ -48,6 +55,7 @@ func (c *Container) commandCheckOperation() *check.Operation {
 		c.provideProjectInfoAssembler(),
 		c.provideSpecAssembler(),
 		c.provideSpecChecker(),
+		c.provideBaseline(),
 		c.provideReferenceRender(),
 		c.flags.UseColors,
 	)
@@ -0,0 +1,37 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/format"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandFmt() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "fmt",
+		Short: "format archfile",
+		Long:  "rewrite archfile in canonical style: sorted vendors, components and deps, normalized lists and quotes (comments are preserved).\nLists inside flow maps ({ in: [ a, b ] }) are left unchanged.\nList items (in, mayDependOn, canUse, etc.) are not sorted and left in written order",
+	}
+
+	in := models.CmdFmtIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		Check:       false,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().BoolVar(&in.Check, "check", in.Check, "only check formatting, without writing archfile (exit with non-zero code, when archfile is not formatted)")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandFmtOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandFmtOperation() *format.Operation {
+	return format.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecFormatter(),
+		c.provideDiffer(),
+	)
+}
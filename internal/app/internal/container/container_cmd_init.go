@@ -0,0 +1,40 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/initialize"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandInit() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "generate archfile from existing project imports",
+		Long:  "scan project imports and generate archfile (v3), that describe current state of project architecture",
+	}
+
+	in := models.CmdInitIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		Depth:       models.DefaultInitDepth,
+		Force:       false,
+		DryRun:      false,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().IntVar(&in.Depth, "depth", in.Depth, "directory depth (from project root), used for grouping packages into components")
+	cmd.PersistentFlags().BoolVar(&in.Force, "force", in.Force, "overwrite archfile, when it already exist")
+	cmd.PersistentFlags().BoolVar(&in.DryRun, "dry-run", in.DryRun, "output generated archfile to stdout, without writing it")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandInitOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandInitOperation() *initialize.Operation {
+	return initialize.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideProjectFilesScanner(),
+	)
+}
@@ -0,0 +1,37 @@
+package container
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/operations/migrate"
+	"github.com/spf13/cobra"
+)
+
+func (c *Container) commandMigrate() (*cobra.Command, runner) {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "upgrade archfile to latest version",
+		Long:  "rewrite archfile of any supported version into equivalent archfile of latest version (comments and keys order are preserved)",
+	}
+
+	in := models.CmdMigrateIn{
+		ProjectPath: models.DefaultProjectPath,
+		ArchFile:    models.DefaultArchFileName,
+		DryRun:      false,
+	}
+
+	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
+	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
+	cmd.PersistentFlags().BoolVar(&in.DryRun, "dry-run", in.DryRun, "only output diff, without writing archfile")
+
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandMigrateOperation().Behave(act.Context(), in)
+	}
+}
+
+func (c *Container) commandMigrateOperation() *migrate.Operation {
+	return migrate.NewOperation(
+		c.provideProjectInfoAssembler(),
+		c.provideSpecMigrator(),
+		c.provideDiffer(),
+	)
+}
@@ -21,14 +21,15 @@ func (c *Container) commandSelfInspect() (*cobra.Command, runner) {
 	cmd.PersistentFlags().StringVar(&in.ProjectPath, "project-path", in.ProjectPath, "absolute path to project directory")
 	cmd.PersistentFlags().StringVar(&in.ArchFile, "arch-file", in.ArchFile, "arch file path")
 
-	return cmd, func(_ *cobra.Command) (any, error) {
-		return c.commandSelfInspectOperation().Behave(in)
+	return cmd, func(act *cobra.Command) (any, error) {
+		return c.commandSelfInspectOperation().Behave(act.Context(), in)
 	}
 }
 
 func (c *Container) commandSelfInspectOperation() *selfInspect.Operation {
 	return selfInspect.NewOperation(
 		c.provideSpecAssembler(),
+		c.provideSpecInspector(),
 		c.provideProjectInfoAssembler(),
 		c.version,
 	)
@@ -16,23 +16,85 @@ type (
 		Components          []Component
 		Exclude             []common.Referable[models.ResolvedPath]
 		ExcludeFilesMatcher []common.Referable[*regexp.Regexp]
+		Layers              LayerPolicy
 		Integrity           Integrity
 	}
 
+	LayerPolicy struct {
+		// Strict is true, when layer can depend only on next layer
+		Strict common.Referable[bool]
+		Layers []Layer // from top to bottom
+	}
+
+	Layer struct {
+		Name       common.Referable[string]
+		Components []string
+	}
+
 	Allow struct {
 		DepOnAnyVendor common.Referable[bool]
 		DeepScan       common.Referable[bool]
+		DepOnAnyStdlib common.Referable[bool]
+		OwnInterfaces  common.Referable[bool]
+		Cycles         common.Referable[bool]
+		Leaks          common.Referable[bool]
 	}
 
 	Component struct {
 		Name                  common.Referable[string]
+		Priority              common.Referable[int]
 		DeepScan              common.Referable[bool]
 		ResolvedPaths         []common.Referable[models.ResolvedPath]
 		AllowedProjectImports []common.Referable[models.ResolvedPath]
-		AllowedVendorGlobs    []common.Referable[models.Glob]
+		AllowedVendorGlobs    []common.Referable[[]models.Glob] // globs of one vendor (can contain exclusions)
+		AllowedStdlibGlobs    []common.Referable[[]models.Glob] // globs of one stdlib group (can contain exclusions)
 		MayDependOn           []common.Referable[string]
 		CanUse                []common.Referable[string]
+		DeniedProjectImports  []DenyProjectRule
+		DeniedVendorImports   []DenyPackageRule
+		DeniedStdlibImports   []DenyPackageRule
+		IsolatedImports       []IsolationRule
+		Exports               ExportPolicy
+		Capabilities          CapabilityPolicy
+		Symbols               SymbolPolicy
 		SpecialFlags          SpecialFlags
+		Common                bool // component is in commonComponents list
+	}
+
+	CapabilityPolicy struct {
+		// Restricted is true, when component code can use only Allowed capabilities
+		Restricted bool
+		Allowed    []common.Referable[models.Capability]
+	}
+
+	DenyProjectRule struct {
+		ComponentName common.Referable[string]
+		ResolvedPaths []models.ResolvedPath
+	}
+
+	SymbolPolicy struct {
+		Forbidden []common.Referable[models.Symbol]
+		Allowed   []common.Referable[models.Symbol] // package with allowed symbols restricted to this symbols
+	}
+
+	ExportPolicy struct {
+		// Restricted is true, when other components can import only Exported packages
+		Restricted bool
+		Exported   []common.Referable[models.ResolvedPath]
+	}
+
+	// IsolationRule deny imports of other component from same isolated group,
+	// except packages of public API
+	IsolationRule struct {
+		Group         common.Referable[string]
+		ComponentName string
+		PublicAPI     common.Referable[string]
+		ResolvedPaths []models.ResolvedPath // not public packages of ComponentName
+	}
+
+	DenyPackageRule struct {
+		Name  common.Referable[string]
+		Globs []models.Glob
 	}
 
 	SpecialFlags struct {
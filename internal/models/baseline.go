@@ -0,0 +1,32 @@
+package models
+
+import "fmt"
+
+const (
+	BaselineKindDependency BaselineKind = "dependency"
+	BaselineKindMatch      BaselineKind = "match"
+	BaselineKindCapability BaselineKind = "capability"
+	BaselineKindDeepscan   BaselineKind = "deepscan"
+	BaselineKindCycle      BaselineKind = "cycle"
+	BaselineKindSymbol     BaselineKind = "symbol"
+	BaselineKindLeak       BaselineKind = "leak"
+	BaselineKindOwnership  BaselineKind = "ownership"
+)
+
+type (
+	BaselineKind string
+
+	// BaselineEntry is fingerprint of check warning, that not depend on
+	// source code lines, so baseline not invalidated after unrelated changes
+	BaselineEntry struct {
+		Kind      BaselineKind `json:"Kind"`                // dependency
+		Component string       `json:"Component,omitempty"` // domain
+		File      string       `json:"File,omitempty"`      // /internal/domain/user.go
+		Target    string       `json:"Target,omitempty"`    // github.com/example/project/internal/infra
+		Via       string       `json:"Via,omitempty"`       // network (capability) or NewService (deepscan gate)
+	}
+)
+
+func (e BaselineEntry) Fingerprint() string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s", e.Kind, e.Component, e.File, e.Target, e.Via)
+}
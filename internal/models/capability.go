@@ -0,0 +1,27 @@
+package models
+
+const (
+	CapabilityNetwork    Capability = "network"
+	CapabilityFilesystem Capability = "filesystem"
+	CapabilityExec       Capability = "exec"
+	CapabilityUnsafe     Capability = "unsafe"
+	CapabilityCGO        Capability = "cgo"
+	CapabilityReflect    Capability = "reflect"
+	CapabilitySyscall    Capability = "syscall"
+)
+
+var CapabilityValues = []string{
+	CapabilityNetwork,
+	CapabilityFilesystem,
+	CapabilityExec,
+	CapabilityUnsafe,
+	CapabilityCGO,
+	CapabilityReflect,
+	CapabilitySyscall,
+}
+
+type (
+	// Capability is abstract "power" of code (like network access),
+	// granted to component by importing some well-known packages
+	Capability = string
+)
@@ -0,0 +1,18 @@
+package models
+
+const (
+	DiffLineKindContext DiffLineKind = " "
+	DiffLineKindAdded   DiffLineKind = "+"
+	DiffLineKindRemoved DiffLineKind = "-"
+	DiffLineKindSkipped DiffLineKind = "~"
+)
+
+type (
+	DiffLineKind = string
+
+	DiffLine struct {
+		Kind DiffLineKind `json:"Kind"`
+		Line int          `json:"Line"`
+		Text string       `json:"Text"`
+	}
+)
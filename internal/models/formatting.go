@@ -0,0 +1,9 @@
+package models
+
+type (
+	// Formatting is result of archfile canonical formatting
+	Formatting struct {
+		Source    []byte
+		Formatted []byte
+	}
+)
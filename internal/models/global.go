@@ -10,5 +10,5 @@ const (
 
 const (
 	SupportedVersionMin = 1
-	SupportedVersionMax = 3
+	SupportedVersionMax = 4
 )
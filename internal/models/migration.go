@@ -0,0 +1,12 @@
+package models
+
+type (
+	// Migration is result of archfile upgrade to latest supported version
+	Migration struct {
+		FromVersion int
+		ToVersion   int
+		Changes     []string
+		Source      []byte
+		Migrated    []byte
+	}
+)
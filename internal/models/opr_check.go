@@ -4,9 +4,12 @@ import "github.com/fe3dback/go-arch-lint/internal/models/common"
 
 type (
 	CmdCheckIn struct {
-		ProjectPath string
-		ArchFile    string
-		MaxWarnings int
+		ProjectPath   string
+		ArchFile      string
+		MaxWarnings   int
+		Strict        bool
+		Baseline      string
+		BaselineWrite string
 	}
 
 	CmdCheckOut struct {
@@ -15,9 +18,26 @@ type (
 		ArchWarningsDependency []CheckArchWarningDependency `json:"ArchWarningsDeps"`
 		ArchWarningsMatch      []CheckArchWarningMatch      `json:"ArchWarningsNotMatched"`
 		ArchWarningsDeepScan   []CheckArchWarningDeepscan   `json:"ArchWarningsDeepScan"`
+		ArchWarningsCapability []CheckArchWarningCapability `json:"ArchWarningsCapability"`
+		ArchWarningsSymbol     []CheckArchWarningSymbol     `json:"ArchWarningsSymbols"`
+		ArchWarningsLeak       []CheckArchWarningLeak       `json:"ArchWarningsLeaks"`
+		ArchWarningsOwnership  []CheckArchWarningOwnership  `json:"ArchWarningsOwnership"`
+		ArchWarningsCycle      []CheckArchWarningCycle      `json:"ArchWarningsCycles"`
+		Suppressions           []CheckSuppression           `json:"Suppressions"`
+		ComponentOverlaps      []CheckComponentOverlap      `json:"ComponentOverlaps"`
+		Strict                 bool                         `json:"Strict"`
 		OmittedCount           int                          `json:"OmittedCount"`
 		ModuleName             string                       `json:"ModuleName"`
 		Qualities              []CheckQuality               `json:"Qualities"`
+		Baseline               *CheckBaseline               `json:"Baseline,omitempty"`
+	}
+
+	CheckBaseline struct {
+		File         string          `json:"File"`         // /app/.go-arch-lint-baseline.json
+		Written      bool            `json:"Written"`      // true, when baseline is written by current check
+		EntriesCount int             `json:"EntriesCount"` // count of warnings in baseline
+		KnownCount   int             `json:"KnownCount"`   // count of warnings, skipped because they are in baseline
+		Fixed        []BaselineEntry `json:"Fixed"`        // baseline entries, not found in project anymore
 	}
 
 	CheckQuality struct {
@@ -36,11 +56,40 @@ type (
 	}
 
 	CheckArchWarningDependency struct {
-		ComponentName      string           `json:"ComponentName"`
-		FileRelativePath   string           `json:"FileRelativePath"`
-		FileAbsolutePath   string           `json:"FileAbsolutePath"`
-		ResolvedImportName string           `json:"ResolvedImportName"`
-		Reference          common.Reference `json:"Reference"`
+		ComponentName      string              `json:"ComponentName"`
+		FileRelativePath   string              `json:"FileRelativePath"`
+		FileAbsolutePath   string              `json:"FileAbsolutePath"`
+		ResolvedImportName string              `json:"ResolvedImportName"`
+		Reference          common.Reference    `json:"Reference"`
+		DenyRule           *DependencyDenyRule `json:"DenyRule,omitempty"`
+		IsolationRule      *IsolationRule      `json:"IsolationRule,omitempty"`
+		ExportRule         *ExportRule         `json:"ExportRule,omitempty"`
+		LayerRule          *LayerRule          `json:"LayerRule,omitempty"`
+	}
+
+	DependencyDenyRule struct {
+		Section   string           `json:"Section"`   // mayNotDependOn
+		Name      string           `json:"Name"`      // repository
+		Reference common.Reference `json:"Reference"` // .go-arch-lint.yml:42
+	}
+
+	LayerRule struct {
+		Layer      string           `json:"Layer"`      // infra
+		ImportedBy string           `json:"ImportedBy"` // domain
+		Next       string           `json:"Next"`       // only for strict layers, when lower layer skipped
+		Reference  common.Reference `json:"Reference"`  // .go-arch-lint.yml:42
+	}
+
+	ExportRule struct {
+		Component string           `json:"Component"` // billing
+		Reference common.Reference `json:"Reference"` // .go-arch-lint.yml:42
+	}
+
+	IsolationRule struct {
+		Group     string           `json:"Group"`     // modules
+		Component string           `json:"Component"` // module-users
+		PublicAPI string           `json:"PublicAPI"` // api
+		Reference common.Reference `json:"Reference"` // .go-arch-lint.yml:42
 	}
 
 	CheckArchWarningMatch struct {
@@ -49,10 +98,60 @@ type (
 		Reference        common.Reference `json:"-"`
 	}
 
+	CheckArchWarningCapability struct {
+		ComponentName      string           `json:"ComponentName"`      // domain
+		Capability         Capability       `json:"Capability"`         // network
+		FileRelativePath   string           `json:"FileRelativePath"`   // /internal/domain/user.go
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`   // /app/internal/domain/user.go
+		ResolvedImportName string           `json:"ResolvedImportName"` // net/http
+		Reference          common.Reference `json:"Reference"`          // /app/internal/domain/user.go:5
+	}
+
+	CheckArchWarningSymbol struct {
+		ComponentName      string           `json:"ComponentName"`      // domain
+		Symbol             Symbol           `json:"Symbol"`             // time.Now
+		Rule               SymbolRule       `json:"Rule"`               // forbidSymbols
+		RuleReference      common.Reference `json:"RuleReference"`      // .go-arch-lint.yml:42
+		FileRelativePath   string           `json:"FileRelativePath"`   // /internal/domain/user.go
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`   // /app/internal/domain/user.go
+		ResolvedImportName string           `json:"ResolvedImportName"` // time
+		Reference          common.Reference `json:"Reference"`          // /app/internal/domain/user.go:12
+		SourceCodePreview  []byte           `json:"-"`
+	}
+
+	CheckArchWarningLeak struct {
+		ComponentName      string           `json:"ComponentName"`      // services
+		Declaration        string           `json:"Declaration"`        // user.NewService
+		DeclarationKind    string           `json:"DeclarationKind"`    // func
+		LeakedType         string           `json:"LeakedType"`         // pg.Row
+		LeakedComponent    string           `json:"LeakedComponent"`    // repository
+		Consumers          []string         `json:"Consumers"`          // [handlers], can use declaration, but not leaked type
+		FileRelativePath   string           `json:"FileRelativePath"`   // /internal/services/user/service.go
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`   // /app/internal/services/user/service.go
+		ResolvedImportName string           `json:"ResolvedImportName"` // github.com/example/app/internal/repository/pg
+		Reference          common.Reference `json:"Reference"`          // /app/internal/services/user/service.go:12
+		SourceCodePreview  []byte           `json:"-"`
+	}
+
+	CheckArchWarningCycle struct {
+		Path  []string         `json:"Path"`  // [a, b, c, a]
+		Edges []CycleEdgeUsage `json:"Edges"` // a -> b, b -> c, c -> a
+	}
+
+	CycleEdgeUsage struct {
+		From               string           `json:"From"`               // a
+		To                 string           `json:"To"`                 // b
+		FileRelativePath   string           `json:"FileRelativePath"`   // /internal/a/a.go
+		FileAbsolutePath   string           `json:"FileAbsolutePath"`   // /app/internal/a/a.go
+		ResolvedImportName string           `json:"ResolvedImportName"` // example.com/app/internal/b
+		Reference          common.Reference `json:"Reference"`          // /app/internal/a/a.go:5
+	}
+
 	CheckArchWarningDeepscan struct {
 		Gate       DeepscanWarningGate       `json:"Gate"`
 		Dependency DeepscanWarningDependency `json:"Dependency"`
 		Target     DeepscanWarningTarget     `json:"Target"`
+		DenyRule   *DependencyDenyRule       `json:"DenyRule,omitempty"`
 	}
 
 	DeepscanWarningGate struct {
@@ -65,6 +164,7 @@ type (
 	DeepscanWarningDependency struct {
 		ComponentName     string           `json:"ComponentName"` // repository
 		Name              string           `json:"Name"`          // micro.ViewRepository
+		ImportPath        string           `json:"ImportPath"`    // example.com/app/internal/repository/micro
 		InjectionAST      string           `json:"InjectionAST"`  // c.provideMicroViewRepository()
 		Injection         common.Reference `json:"Injection"`     // internal/app/internal/container/cmd_mapping.go:15
 		InjectionPath     string           `json:"-"`             // internal/app/internal/container/cmd_mapping.go:15
@@ -76,10 +176,52 @@ type (
 		RelativePath string           `json:"-"` // internal/app/internal/container/cmd_mapping.go:15
 	}
 
+	// CheckArchWarningOwnership is interface param of component method,
+	// when interface declared not in this component (allow.ownInterfaces)
+	CheckArchWarningOwnership struct {
+		Gate              DeepscanWarningGate      `json:"Gate"`
+		Interface         DeepscanWarningInterface `json:"Interface"`
+		SourceCodePreview []byte                   `json:"-"`
+	}
+
+	DeepscanWarningInterface struct {
+		ComponentName string           `json:"ComponentName"` // repository (interface owner)
+		Name          string           `json:"Name"`          // pg.Finder
+		ImportPath    string           `json:"ImportPath"`    // example.com/app/internal/repository/pg
+		Definition    common.Reference `json:"Definition"`    // internal/repository/pg/finder.go:8
+		RelativePath  string           `json:"-"`             // internal/repository/pg/finder.go:8
+	}
+
+	CheckSuppression struct {
+		FileRelativePath string           `json:"FileRelativePath"` // /internal/domain/user.go
+		FileAbsolutePath string           `json:"FileAbsolutePath"` // /app/internal/domain/user.go
+		Imports          []string         `json:"Imports"`          // [net/http], or empty for whole file
+		Reason           string           `json:"Reason"`           // legacy code, will be moved to infra
+		Valid            bool             `json:"Valid"`            // false, when reason is not specified
+		SuppressedCount  int              `json:"SuppressedCount"`  // 2
+		Reference        common.Reference `json:"Reference"`        // /app/internal/domain/user.go:5
+	}
+
+	CheckComponentOverlap struct {
+		PackageRelativePath string             `json:"PackageRelativePath"` // /internal/a/b
+		PackageAbsolutePath string             `json:"PackageAbsolutePath"` // /app/internal/a/b
+		Candidates          []OverlapCandidate `json:"Candidates"`          // [b (3 files), a (10 files)]
+		Winner              string             `json:"Winner"`              // b
+		Rule                OverlapRule        `json:"Rule"`                // fewest matched files
+		Ambiguous           bool               `json:"Ambiguous"`           // true, when winner is not chosen by explicit priority
+	}
+
 	CheckResult struct {
 		DependencyWarnings []CheckArchWarningDependency
 		MatchWarnings      []CheckArchWarningMatch
 		DeepscanWarnings   []CheckArchWarningDeepscan
+		CapabilityWarnings []CheckArchWarningCapability
+		SymbolWarnings     []CheckArchWarningSymbol
+		LeakWarnings       []CheckArchWarningLeak
+		OwnershipWarnings  []CheckArchWarningOwnership
+		CycleWarnings      []CheckArchWarningCycle
+		Suppressions       []CheckSuppression
+		Overlaps           []CheckComponentOverlap
 	}
 )
 
@@ -87,6 +229,11 @@ func (cr *CheckResult) Append(another CheckResult) {
 	cr.DependencyWarnings = append(cr.DependencyWarnings, another.DependencyWarnings...)
 	cr.MatchWarnings = append(cr.MatchWarnings, another.MatchWarnings...)
 	cr.DeepscanWarnings = append(cr.DeepscanWarnings, another.DeepscanWarnings...)
+	cr.CapabilityWarnings = append(cr.CapabilityWarnings, another.CapabilityWarnings...)
+	cr.SymbolWarnings = append(cr.SymbolWarnings, another.SymbolWarnings...)
+	cr.LeakWarnings = append(cr.LeakWarnings, another.LeakWarnings...)
+	cr.OwnershipWarnings = append(cr.OwnershipWarnings, another.OwnershipWarnings...)
+	cr.CycleWarnings = append(cr.CycleWarnings, another.CycleWarnings...)
 }
 
 func (cr *CheckResult) HasNotices() bool {
@@ -99,6 +246,21 @@ func (cr *CheckResult) HasNotices() bool {
 	if len(cr.DeepscanWarnings) > 0 {
 		return true
 	}
+	if len(cr.CapabilityWarnings) > 0 {
+		return true
+	}
+	if len(cr.SymbolWarnings) > 0 {
+		return true
+	}
+	if len(cr.LeakWarnings) > 0 {
+		return true
+	}
+	if len(cr.OwnershipWarnings) > 0 {
+		return true
+	}
+	if len(cr.CycleWarnings) > 0 {
+		return true
+	}
 
 	return false
 }
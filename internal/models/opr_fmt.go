@@ -0,0 +1,16 @@
+package models
+
+type (
+	CmdFmtIn struct {
+		ProjectPath string
+		ArchFile    string
+		Check       bool
+	}
+
+	CmdFmtOut struct {
+		ArchFile  string     `json:"ArchFile"`
+		Formatted bool       `json:"Formatted"`
+		Diff      []DiffLine `json:"Diff"`
+		Check     bool       `json:"Check"`
+	}
+)
@@ -0,0 +1,23 @@
+package models
+
+const DefaultInitDepth = 2
+
+type (
+	CmdInitIn struct {
+		ProjectPath string
+		ArchFile    string
+		Depth       int
+		Force       bool
+		DryRun      bool
+	}
+
+	CmdInitOut struct {
+		ProjectDirectory string `json:"ProjectDirectory"`
+		ModuleName       string `json:"ModuleName"`
+		ArchFile         string `json:"ArchFile"`
+		ComponentsCount  int    `json:"ComponentsCount"`
+		VendorsCount     int    `json:"VendorsCount"`
+		Content          string `json:"Content"`
+		DryRun           bool   `json:"DryRun"`
+	}
+)
@@ -24,6 +24,7 @@ type (
 		ModuleName       string                 `json:"ModuleName"`
 		MappingGrouped   []CmdMappingOutGrouped `json:"MappingGrouped"`
 		MappingList      []CmdMappingOutList    `json:"MappingList"`
+		SplitPackages    map[string]bool        `json:"-"` // absolute package path -> package files split by file globs
 		Scheme           MappingScheme          `json:"-"`
 	}
 
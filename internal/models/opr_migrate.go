@@ -0,0 +1,18 @@
+package models
+
+type (
+	CmdMigrateIn struct {
+		ProjectPath string
+		ArchFile    string
+		DryRun      bool
+	}
+
+	CmdMigrateOut struct {
+		ArchFile    string     `json:"ArchFile"`
+		FromVersion int        `json:"FromVersion"`
+		ToVersion   int        `json:"ToVersion"`
+		Changes     []string   `json:"Changes"`
+		Diff        []DiffLine `json:"Diff"`
+		DryRun      bool       `json:"DryRun"`
+	}
+)
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/fe3dback/go-arch-lint/internal/models/common"
+import (
+	"path/filepath"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
 
 const (
 	ImportTypeStdLib ImportType = iota
@@ -8,17 +12,48 @@ const (
 	ImportTypeVendor
 )
 
+const (
+	OverlapRulePriority   OverlapRule = "highest priority"
+	OverlapRuleFileMatch  OverlapRule = "file match"
+	OverlapRuleFilesCount OverlapRule = "fewest matched files"
+	OverlapRuleDepth      OverlapRule = "deepest path"
+	OverlapRuleNameLength OverlapRule = "longest name"
+	OverlapRuleNameOrder  OverlapRule = "name order"
+)
+
 type (
 	ImportType uint8
 
+	OverlapRule string
+
 	FileHold struct {
-		File        ProjectFile
-		ComponentID *string
+		File          ProjectFile
+		ComponentID   *string
+		MatchedByFile bool              // component matched file by file glob (not by package directory)
+		Overlap       *ComponentOverlap // not nil, when file matched by several components
+	}
+
+	ComponentOverlap struct {
+		Candidates []OverlapCandidate // sorted by priority, first is winner
+		Rule       OverlapRule        // rule, used for choosing between winner and second candidate
+	}
+
+	OverlapCandidate struct {
+		Name       string `json:"Name"`
+		FilesCount int    `json:"FilesCount"` // count of all project files matched by component
 	}
 
 	ProjectFile struct {
-		Path    string
-		Imports []ResolvedImport
+		Path         string
+		Imports      []ResolvedImport
+		Suppressions []Suppression
+	}
+
+	// Suppression is "//go-arch-lint:ignore <reason>" directive in source code
+	Suppression struct {
+		Reason    string           // empty, when directive is invalid (reason is required)
+		Imports   []string         // suppressed imports, or empty for whole file suppression
+		Reference common.Reference // directive position
 	}
 
 	ResolvedImport struct {
@@ -27,3 +62,33 @@ type (
 		Reference  common.Reference
 	}
 )
+
+// PackageComponents return owner component of every project package
+// (by package directory). When package is split by file globs, package
+// is owned by component, matched by package directory.
+func PackageComponents(holds []FileHold) map[string]string {
+	owners := make(map[string]string)
+	ownedByFile := make(map[string]bool)
+
+	for _, hold := range holds {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		packagePath := filepath.Dir(hold.File.Path)
+		current, exist := owners[packagePath]
+
+		switch {
+		case !exist:
+		case ownedByFile[packagePath] && !hold.MatchedByFile:
+		case ownedByFile[packagePath] == hold.MatchedByFile && *hold.ComponentID < current:
+		default:
+			continue
+		}
+
+		owners[packagePath] = *hold.ComponentID
+		ownedByFile[packagePath] = hold.MatchedByFile
+	}
+
+	return owners
+}
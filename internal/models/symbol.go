@@ -0,0 +1,44 @@
+package models
+
+import "strings"
+
+const (
+	SymbolRuleForbid SymbolRule = "forbidSymbols"
+	SymbolRuleAllow  SymbolRule = "allowSymbols"
+)
+
+type (
+	// Symbol is package level identifier, qualified by
+	// full package import path: "time.Now", "github.com/pkg/errors.Wrap"
+	Symbol = string
+
+	// SymbolRule is deps rule section, that deny symbol usage
+	SymbolRule = string
+)
+
+// SymbolPackage return import path of symbol package ("github.com/pkg/errors")
+func SymbolPackage(symbol Symbol) string {
+	pkg, _ := splitSymbol(symbol)
+	return pkg
+}
+
+// SymbolName return identifier of symbol ("Wrap")
+func SymbolName(symbol Symbol) string {
+	_, name := splitSymbol(symbol)
+	return name
+}
+
+// SymbolValid is true, when symbol has both package and identifier
+func SymbolValid(symbol Symbol) bool {
+	pkg, name := splitSymbol(symbol)
+	return pkg != "" && name != "" && !strings.Contains(name, "/")
+}
+
+func splitSymbol(symbol Symbol) (pkg string, name string) {
+	ind := strings.LastIndex(symbol, ".")
+	if ind == -1 {
+		return "", symbol
+	}
+
+	return symbol[:ind], symbol[ind+1:]
+}
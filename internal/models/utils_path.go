@@ -6,6 +6,8 @@ import (
 	"strings"
 )
 
+const globExclusionPrefix = "!"
+
 type (
 	Glob string
 
@@ -13,6 +15,7 @@ type (
 		ImportPath string
 		LocalPath  string
 		AbsPath    string
+		File       bool // path is go file (matched by file glob), ImportPath is path of file package
 	}
 )
 
@@ -47,3 +50,170 @@ func (glob Glob) Match(testedPath string) (bool, error) {
 
 	return matcher.MatchString(testedPath), nil
 }
+
+// Overlaps check if at least one path can be matched by both globs, for example:
+//   - github.com/example/**
+//   - github.com/*/internal/**
+//
+// overlaps (both match github.com/example/internal/lib), but
+//   - github.com/example/**
+//   - github.com/other/**
+//
+// not overlaps
+func (glob Glob) Overlaps(other Glob) bool {
+	left, right := glob.tokens(), other.tokens()
+	visited := make(map[[2]int]struct{})
+
+	var walk func(l, r int) bool
+	walk = func(l, r int) bool {
+		if _, ok := visited[[2]int{l, r}]; ok {
+			return false
+		}
+		visited[[2]int{l, r}] = struct{}{}
+
+		if l == len(left) && r == len(right) {
+			return true
+		}
+
+		// repeatable tokens can match empty string
+		if l < len(left) && left[l].repeatable && walk(l+1, r) {
+			return true
+		}
+		if r < len(right) && right[r].repeatable && walk(l, r+1) {
+			return true
+		}
+
+		if l == len(left) || r == len(right) || !left[l].compatible(right[r]) {
+			return false
+		}
+
+		// both globs consume same char, repeatable tokens stay on place
+		nextL, nextR := l+1, r+1
+		if left[l].repeatable {
+			nextL = l
+		}
+		if right[r].repeatable {
+			nextR = r
+		}
+
+		return walk(nextL, nextR)
+	}
+
+	return walk(0, 0)
+}
+
+// globToken is one char of glob pattern, wildcards are
+// represented as char classes, same as in Match regexp
+type globToken struct {
+	char       byte
+	anyChar    bool // wildcard, match any char except '/'
+	slash      bool // wildcard can match '/' too ('**')
+	repeatable bool // token can match zero or more chars
+}
+
+func (glob Glob) tokens() []globToken {
+	pattern := string(glob.Pattern())
+	tokens := make([]globToken, 0, len(pattern))
+
+	for i := 0; i < len(pattern); i++ {
+		if strings.HasPrefix(pattern[i:], "**") {
+			tokens = append(tokens, globToken{anyChar: true, slash: true, repeatable: true})
+			i++
+			continue
+		}
+
+		if pattern[i] == '*' {
+			// at least one char of path segment
+			tokens = append(tokens,
+				globToken{anyChar: true},
+				globToken{anyChar: true, repeatable: true},
+			)
+			continue
+		}
+
+		tokens = append(tokens, globToken{char: pattern[i]})
+	}
+
+	return tokens
+}
+
+func (t globToken) compatible(other globToken) bool {
+	switch {
+	case !t.anyChar && !other.anyChar:
+		return t.char == other.char
+	case !t.anyChar:
+		return other.slash || t.char != '/'
+	case !other.anyChar:
+		return t.slash || other.char != '/'
+	default:
+		return true
+	}
+}
+
+// IsExclusion is true for negative globs (started with "!"),
+// that exclude paths matched by other globs from same list
+func (glob Glob) IsExclusion() bool {
+	return strings.HasPrefix(string(glob), globExclusionPrefix)
+}
+
+// Pattern return glob without exclusion prefix
+func (glob Glob) Pattern() Glob {
+	return Glob(strings.TrimPrefix(string(glob), globExclusionPrefix))
+}
+
+// IsFile is true for globs of go files (internal/user/*_grpc.go),
+// that match only some files of package
+func (glob Glob) IsFile() bool {
+	return strings.HasSuffix(string(glob), ".go")
+}
+
+// MatchGlobs check if path matched by at least one glob from list,
+// and not matched by any exclusion glob, for example:
+//   - github.com/example/**
+//   - !github.com/example/internal/**
+//
+// will match:
+//   - github.com/example/lib
+//
+// and not match:
+//   - github.com/example/internal/lib
+func MatchGlobs(globs []Glob, testedPath string) (bool, error) {
+	matched := false
+
+	for _, glob := range globs {
+		if glob.IsExclusion() {
+			continue
+		}
+
+		ok, err := glob.Match(testedPath)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			matched = true
+			break
+		}
+	}
+
+	if !matched {
+		return false, nil
+	}
+
+	for _, glob := range globs {
+		if !glob.IsExclusion() {
+			continue
+		}
+
+		excluded, err := glob.Pattern().Match(testedPath)
+		if err != nil {
+			return false, err
+		}
+
+		if excluded {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
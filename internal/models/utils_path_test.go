@@ -0,0 +1,77 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+func TestGlob_Overlaps(t *testing.T) {
+	tests := []struct {
+		name  string
+		glob  models.Glob
+		other models.Glob
+		want  bool
+	}{
+		{
+			name:  "same path",
+			glob:  "github.com/example/lib",
+			other: "github.com/example/lib",
+			want:  true,
+		},
+		{
+			name:  "different path",
+			glob:  "github.com/example/lib",
+			other: "github.com/example/other",
+			want:  false,
+		},
+		{
+			name:  "super glob and single glob",
+			glob:  "github.com/example/**",
+			other: "github.com/*/internal/**",
+			want:  true,
+		},
+		{
+			name:  "different prefix",
+			glob:  "github.com/example/b/**",
+			other: "github.com/other/**",
+			want:  false,
+		},
+		{
+			name:  "single glob not match slash",
+			glob:  "github.com/*",
+			other: "github.com/example/lib",
+			want:  false,
+		},
+		{
+			name:  "single glob not match empty segment",
+			glob:  "github.com/*/lib",
+			other: "github.com//lib",
+			want:  false,
+		},
+		{
+			name:  "part of segment",
+			glob:  "github.com/module-*",
+			other: "github.com/*-v2",
+			want:  true,
+		},
+		{
+			name:  "exclusion prefix ignored",
+			glob:  "golang.org/x/**",
+			other: "!golang.org/x/net/**",
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.glob.Overlaps(tt.other); got != tt.want {
+				t.Errorf("Overlaps() = %v, want %v", got, tt.want)
+			}
+
+			if got := tt.other.Overlaps(tt.glob); got != tt.want {
+				t.Errorf("Overlaps() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package check
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
 type (
@@ -14,6 +16,7 @@ type (
 		projectInfoAssembler projectInfoAssembler
 		specAssembler        specAssembler
 		specChecker          specChecker
+		baseline             baseline
 		referenceRender      referenceRender
 		highlightCodePreview bool
 	}
@@ -28,6 +31,7 @@ func NewOperation(
 	projectInfoAssembler projectInfoAssembler,
 	specAssembler specAssembler,
 	specChecker specChecker,
+	baseline baseline,
 	referenceRender referenceRender,
 	highlightCodePreview bool,
 ) *Operation {
@@ -35,6 +39,7 @@ func NewOperation(
 		projectInfoAssembler: projectInfoAssembler,
 		specAssembler:        specAssembler,
 		specChecker:          specChecker,
+		baseline:             baseline,
 		referenceRender:      referenceRender,
 		highlightCodePreview: highlightCodePreview,
 	}
@@ -51,9 +56,13 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 		return models.CmdCheckOut{}, fmt.Errorf("failed to assemble spec: %w", err)
 	}
 
-	result := models.CheckResult{}
+	result := models.CheckResult{
+		Suppressions: []models.CheckSuppression{},
+		Overlaps:     []models.CheckComponentOverlap{},
+	}
+	var baselineInfo *models.CheckBaseline
 	if len(spec.Integrity.DocumentNotices) == 0 {
-		result, err = o.specChecker.Check(ctx, spec)
+		result, baselineInfo, err = o.check(ctx, projectInfo, spec, in)
 		if err != nil {
 			return models.CmdCheckOut{}, fmt.Errorf("failed to check project deps: %w", err)
 		}
@@ -64,11 +73,20 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 	model := models.CmdCheckOut{
 		ModuleName:             spec.ModuleName.Value,
 		DocumentNotices:        o.assembleNotice(spec.Integrity),
-		ArchHasWarnings:        o.resultsHasWarnings(limitedResult.results),
+		ArchHasWarnings:        o.resultsHasWarnings(limitedResult.results) || (in.Strict && o.hasAmbiguousOverlaps(result.Overlaps)),
 		ArchWarningsDependency: limitedResult.results.DependencyWarnings,
 		ArchWarningsMatch:      limitedResult.results.MatchWarnings,
 		ArchWarningsDeepScan:   limitedResult.results.DeepscanWarnings,
+		ArchWarningsCapability: limitedResult.results.CapabilityWarnings,
+		ArchWarningsSymbol:     limitedResult.results.SymbolWarnings,
+		ArchWarningsLeak:       limitedResult.results.LeakWarnings,
+		ArchWarningsOwnership:  limitedResult.results.OwnershipWarnings,
+		ArchWarningsCycle:      limitedResult.results.CycleWarnings,
+		Suppressions:           result.Suppressions,
+		ComponentOverlaps:      result.Overlaps,
+		Strict:                 in.Strict,
 		OmittedCount:           limitedResult.omittedCount,
+		Baseline:               baselineInfo,
 		Qualities: []models.CheckQuality{
 			{
 				ID:   "component_imports",
@@ -82,12 +100,36 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 				Used: spec.Allow.DepOnAnyVendor.Value == false,
 				Hint: "switch 'allow.depOnAnyVendor = false' (or delete) to on",
 			},
+			{
+				ID:   "stdlib_imports",
+				Name: "Advanced: stdlib imports",
+				Used: spec.Allow.DepOnAnyStdlib.Value == false,
+				Hint: "switch 'allow.depOnAnyStdlib = false' to on (v4+)",
+			},
+			{
+				ID:   "import_cycles",
+				Name: "Advanced: component import cycles",
+				Used: spec.Allow.Cycles.Value == false,
+				Hint: "switch 'allow.cycles = false' to on (v4+)",
+			},
 			{
 				ID:   "deepscan",
 				Name: "Advanced: method calls and dependency injections",
 				Used: spec.Allow.DeepScan.Value == true,
 				Hint: "switch 'allow.deepScan = true' (or delete) to on",
 			},
+			{
+				ID:   "leaks",
+				Name: "Advanced: leaky abstractions",
+				Used: spec.Allow.DeepScan.Value == true && spec.Allow.Leaks.Value == false,
+				Hint: "switch 'allow.leaks = false' to on (v4+, with deepScan)",
+			},
+			{
+				ID:   "own_interfaces",
+				Name: "Advanced: interfaces owned by consumer",
+				Used: spec.Allow.DeepScan.Value == true && spec.Allow.OwnInterfaces.Value == true,
+				Hint: "switch 'allow.ownInterfaces = true' to on (v4+, with deepScan)",
+			},
 		},
 	}
 
@@ -99,12 +141,82 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdCheckIn) (models.Cm
 	return model, nil
 }
 
+func (o *Operation) check(
+	ctx context.Context,
+	projectInfo common.Project,
+	spec arch.Spec,
+	in models.CmdCheckIn,
+) (models.CheckResult, *models.CheckBaseline, error) {
+	if in.Baseline == "" && in.BaselineWrite == "" {
+		result, err := o.specChecker.Check(ctx, spec)
+		return result, nil, err
+	}
+
+	// baseline should know about all warnings, so
+	// all checkers should be executed
+	result, err := o.specChecker.CheckAll(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, nil, err
+	}
+
+	rootDirectory := spec.RootDirectory.Value
+
+	if in.BaselineWrite != "" {
+		baselinePath := o.baselinePath(projectInfo, in.BaselineWrite)
+		entries := o.baseline.Fingerprints(rootDirectory, result)
+
+		err = o.baseline.Write(baselinePath, entries)
+		if err != nil {
+			return models.CheckResult{}, nil, err
+		}
+
+		filtered, knownCount, _ := o.baseline.Filter(rootDirectory, entries, result)
+		return filtered, &models.CheckBaseline{
+			File:         baselinePath,
+			Written:      true,
+			EntriesCount: len(entries),
+			KnownCount:   knownCount,
+			Fixed:        []models.BaselineEntry{},
+		}, nil
+	}
+
+	baselinePath := o.baselinePath(projectInfo, in.Baseline)
+	entries, err := o.baseline.Read(baselinePath)
+	if err != nil {
+		return models.CheckResult{}, nil, err
+	}
+
+	filtered, knownCount, fixed := o.baseline.Filter(rootDirectory, entries, result)
+	return filtered, &models.CheckBaseline{
+		File:         baselinePath,
+		Written:      false,
+		EntriesCount: len(entries),
+		KnownCount:   knownCount,
+		Fixed:        fixed,
+	}, nil
+}
+
+// baselinePath resolve baseline file path, relative paths
+// is resolved from project directory (same as archfile)
+func (o *Operation) baselinePath(projectInfo common.Project, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Clean(fmt.Sprintf("%s/%s", projectInfo.Directory, path))
+}
+
 func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) limiterResult {
 	passCount := 0
 	limitedResults := models.CheckResult{
 		DependencyWarnings: []models.CheckArchWarningDependency{},
 		MatchWarnings:      []models.CheckArchWarningMatch{},
 		DeepscanWarnings:   []models.CheckArchWarningDeepscan{},
+		CapabilityWarnings: []models.CheckArchWarningCapability{},
+		SymbolWarnings:     []models.CheckArchWarningSymbol{},
+		LeakWarnings:       []models.CheckArchWarningLeak{},
+		OwnershipWarnings:  []models.CheckArchWarningOwnership{},
+		CycleWarnings:      []models.CheckArchWarningCycle{},
 	}
 
 	// append deps
@@ -127,6 +239,56 @@ func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) lim
 		passCount++
 	}
 
+	// append capabilities
+	for _, notice := range result.CapabilityWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.CapabilityWarnings = append(limitedResults.CapabilityWarnings, notice)
+		passCount++
+	}
+
+	// append symbols
+	for _, notice := range result.SymbolWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.SymbolWarnings = append(limitedResults.SymbolWarnings, notice)
+		passCount++
+	}
+
+	// append leaks
+	for _, notice := range result.LeakWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.LeakWarnings = append(limitedResults.LeakWarnings, notice)
+		passCount++
+	}
+
+	// append interface ownership
+	for _, notice := range result.OwnershipWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.OwnershipWarnings = append(limitedResults.OwnershipWarnings, notice)
+		passCount++
+	}
+
+	// append cycles
+	for _, notice := range result.CycleWarnings {
+		if passCount >= maxWarnings {
+			break
+		}
+
+		limitedResults.CycleWarnings = append(limitedResults.CycleWarnings, notice)
+		passCount++
+	}
+
 	// append deep scan
 	for _, notice := range result.DeepscanWarnings {
 		if passCount >= maxWarnings {
@@ -140,7 +302,12 @@ func (o *Operation) limitResults(result models.CheckResult, maxWarnings int) lim
 	totalCount := 0 +
 		len(result.DeepscanWarnings) +
 		len(result.DependencyWarnings) +
-		len(result.MatchWarnings)
+		len(result.MatchWarnings) +
+		len(result.CapabilityWarnings) +
+		len(result.SymbolWarnings) +
+		len(result.LeakWarnings) +
+		len(result.OwnershipWarnings) +
+		len(result.CycleWarnings)
 
 	return limiterResult{
 		results:      limitedResults,
@@ -161,6 +328,38 @@ func (o *Operation) resultsHasWarnings(result models.CheckResult) bool {
 		return true
 	}
 
+	if len(result.CapabilityWarnings) > 0 {
+		return true
+	}
+
+	if len(result.SymbolWarnings) > 0 {
+		return true
+	}
+
+	if len(result.LeakWarnings) > 0 {
+		return true
+	}
+
+	if len(result.OwnershipWarnings) > 0 {
+		return true
+	}
+
+	if len(result.CycleWarnings) > 0 {
+		return true
+	}
+
+	return false
+}
+
+// overlaps resolved by explicit component priority
+// is not ambiguous, and not fail check in strict mode
+func (o *Operation) hasAmbiguousOverlaps(overlaps []models.CheckComponentOverlap) bool {
+	for _, overlap := range overlaps {
+		if overlap.Ambiguous {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -23,5 +23,13 @@ type (
 
 	specChecker interface {
 		Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+		CheckAll(ctx context.Context, spec arch.Spec) (models.CheckResult, error)
+	}
+
+	baseline interface {
+		Fingerprints(rootDirectory string, result models.CheckResult) []models.BaselineEntry
+		Filter(rootDirectory string, entries []models.BaselineEntry, result models.CheckResult) (models.CheckResult, int, []models.BaselineEntry)
+		Read(path string) ([]models.BaselineEntry, error)
+		Write(path string, entries []models.BaselineEntry) error
 	}
 )
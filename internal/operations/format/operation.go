@@ -0,0 +1,70 @@
+package format
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specFormatter        specFormatter
+	differ               differ
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specFormatter specFormatter,
+	differ differ,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specFormatter:        specFormatter,
+		differ:               differ,
+	}
+}
+
+func (o *Operation) Behave(_ context.Context, in models.CmdFmtIn) (models.CmdFmtOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdFmtOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	formatting, err := o.specFormatter.Format(projectInfo.GoArchFilePath)
+	if err != nil {
+		return models.CmdFmtOut{}, fmt.Errorf("failed to format archfile: %w", err)
+	}
+
+	alreadyFormatted := bytes.Equal(formatting.Source, formatting.Formatted)
+	model := models.CmdFmtOut{
+		ArchFile:  projectInfo.GoArchFilePath,
+		Formatted: alreadyFormatted,
+		Diff:      []models.DiffLine{},
+		Check:     in.Check,
+	}
+
+	if alreadyFormatted {
+		return model, nil
+	}
+
+	model.Diff = o.differ.Diff(formatting.Source, formatting.Formatted)
+
+	if in.Check {
+		return model, models.NewUserSpaceError("archfile is not formatted")
+	}
+
+	stat, err := os.Stat(projectInfo.GoArchFilePath)
+	if err != nil {
+		return models.CmdFmtOut{}, fmt.Errorf("failed stat archfile: %w", err)
+	}
+
+	err = os.WriteFile(projectInfo.GoArchFilePath, formatting.Formatted, stat.Mode())
+	if err != nil {
+		return models.CmdFmtOut{}, fmt.Errorf("failed write formatted archfile into '%s': %w", projectInfo.GoArchFilePath, err)
+	}
+
+	return model, nil
+}
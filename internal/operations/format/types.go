@@ -0,0 +1,20 @@
+package format
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specFormatter interface {
+		Format(archFilePath string) (models.Formatting, error)
+	}
+
+	differ interface {
+		Diff(before, after []byte) []models.DiffLine
+	}
+)
@@ -0,0 +1,477 @@
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const excludeTestFilesRegExp = "^.*_test\\.go$"
+
+type (
+	Operation struct {
+		projectInfoAssembler projectInfoAssembler
+		projectFilesScanner  projectFilesScanner
+	}
+
+	draft struct {
+		excludes   []string
+		components map[string]*draftComponent // path key -> component
+		vendors    map[string]*draftVendor    // module path -> vendor
+	}
+
+	draftComponent struct {
+		pathKey     string
+		name        string
+		nested      bool
+		mayDependOn map[string]struct{} // path keys
+		canUse      map[string]struct{} // module paths
+	}
+
+	draftVendor struct {
+		modulePath string
+		name       string
+	}
+)
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	projectFilesScanner projectFilesScanner,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		projectFilesScanner:  projectFilesScanner,
+	}
+}
+
+func (o *Operation) Behave(ctx context.Context, in models.CmdInitIn) (models.CmdInitOut, error) {
+	if in.Depth < 1 {
+		return models.CmdInitOut{}, fmt.Errorf("depth should be greater than zero, got %d", in.Depth)
+	}
+
+	projectInfo, err := o.projectInfoAssembler.ModuleInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	if !in.DryRun && !in.Force {
+		if _, err := os.Stat(projectInfo.GoArchFilePath); err == nil {
+			return models.CmdInitOut{}, fmt.Errorf("archfile '%s' already exist, use --force for overwrite it",
+				projectInfo.GoArchFilePath,
+			)
+		}
+	}
+
+	requirements, err := o.projectInfoAssembler.ModuleRequirements(projectInfo.GoModFilePath)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to get module requirements: %w", err)
+	}
+
+	excludes, err := o.findExcludedDirectories(projectInfo.Directory)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to find excluded directories: %w", err)
+	}
+
+	excludePaths := make([]models.ResolvedPath, 0, len(excludes))
+	for _, exclude := range excludes {
+		excludePaths = append(excludePaths, models.ResolvedPath{
+			LocalPath: exclude,
+			AbsPath:   filepath.Join(projectInfo.Directory, exclude),
+		})
+	}
+
+	files, err := o.projectFilesScanner.Scan(
+		ctx,
+		projectInfo.Directory,
+		projectInfo.ModuleName,
+		excludePaths,
+		[]*regexp.Regexp{regexp.MustCompile(excludeTestFilesRegExp)},
+	)
+	if err != nil {
+		return models.CmdInitOut{}, fmt.Errorf("failed to scan project files: %w", err)
+	}
+
+	if len(files) == 0 {
+		return models.CmdInitOut{}, fmt.Errorf("not found any go files in '%s'", projectInfo.Directory)
+	}
+
+	doc := o.buildDraft(projectInfo.Directory, projectInfo.ModuleName, requirements, in.Depth, files)
+	doc.excludes = excludes
+	content := o.render(doc)
+
+	if !in.DryRun {
+		err = os.WriteFile(projectInfo.GoArchFilePath, []byte(content), 0o644)
+		if err != nil {
+			return models.CmdInitOut{}, fmt.Errorf("failed write archfile into '%s': %w", projectInfo.GoArchFilePath, err)
+		}
+	}
+
+	return models.CmdInitOut{
+		ProjectDirectory: projectInfo.Directory,
+		ModuleName:       projectInfo.ModuleName,
+		ArchFile:         projectInfo.GoArchFilePath,
+		ComponentsCount:  len(doc.components),
+		VendorsCount:     len(doc.vendors),
+		Content:          content,
+		DryRun:           in.DryRun,
+	}, nil
+}
+
+// findExcludedDirectories return relative paths of all directories,
+// that go tool itself will ignore (vendor, testdata, hidden, etc..)
+// and directories with nested go modules
+func (o *Operation) findExcludedDirectories(projectDirectory string) ([]string, error) {
+	excludes := make([]string, 0)
+
+	err := filepath.Walk(projectDirectory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() || path == projectDirectory {
+			return nil
+		}
+
+		name := info.Name()
+		ignored := name == "vendor" ||
+			name == "testdata" ||
+			strings.HasPrefix(name, ".") ||
+			strings.HasPrefix(name, "_") ||
+			isModuleRoot(path)
+
+		if !ignored {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(projectDirectory, path)
+		if err != nil {
+			return fmt.Errorf("failed get relative path of '%s': %w", path, err)
+		}
+
+		excludes = append(excludes, filepath.ToSlash(relPath))
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(excludes)
+	return excludes, nil
+}
+
+func isModuleRoot(directory string) bool {
+	_, err := os.Stat(filepath.Join(directory, models.DefaultGoModFileName))
+	return err == nil
+}
+
+func (o *Operation) buildDraft(
+	projectDirectory string,
+	moduleName string,
+	requirements []string,
+	depth int,
+	files []models.ProjectFile,
+) draft {
+	doc := draft{
+		components: map[string]*draftComponent{},
+		vendors:    map[string]*draftVendor{},
+	}
+
+	component := func(relDir string) *draftComponent {
+		pathKey, nested := componentPathKey(relDir, depth)
+		cmp, exist := doc.components[pathKey]
+		if !exist {
+			cmp = &draftComponent{
+				pathKey:     pathKey,
+				mayDependOn: map[string]struct{}{},
+				canUse:      map[string]struct{}{},
+			}
+			doc.components[pathKey] = cmp
+		}
+
+		cmp.nested = cmp.nested || nested
+		return cmp
+	}
+
+	for _, file := range files {
+		relDir, err := filepath.Rel(projectDirectory, filepath.Dir(file.Path))
+		if err != nil {
+			continue
+		}
+
+		cmp := component(filepath.ToSlash(relDir))
+
+		for _, resolvedImport := range file.Imports {
+			switch resolvedImport.ImportType {
+			case models.ImportTypeProject:
+				importDir := strings.TrimPrefix(strings.TrimPrefix(resolvedImport.Name, moduleName), "/")
+				if importDir == "" {
+					importDir = "."
+				}
+
+				depKey, _ := componentPathKey(importDir, depth)
+				cmp.mayDependOn[depKey] = struct{}{}
+			case models.ImportTypeVendor:
+				modulePath := vendorModulePath(resolvedImport.Name, requirements)
+				if _, exist := doc.vendors[modulePath]; !exist {
+					doc.vendors[modulePath] = &draftVendor{modulePath: modulePath}
+				}
+
+				cmp.canUse[modulePath] = struct{}{}
+			}
+		}
+	}
+
+	// imported project packages may not contain files in scope
+	// (all files excluded), so this deps should be created as well
+	for _, cmp := range doc.components {
+		for depKey := range cmp.mayDependOn {
+			if _, exist := doc.components[depKey]; !exist {
+				component(depKey)
+			}
+		}
+	}
+
+	assignComponentNames(doc.components)
+	assignVendorNames(doc.vendors)
+
+	return doc
+}
+
+// componentPathKey cut relative directory to max depth.
+// Nested flag is true, when directory is deeper than result key
+func componentPathKey(relDir string, depth int) (string, bool) {
+	if relDir == "." {
+		return relDir, false
+	}
+
+	parts := strings.Split(relDir, "/")
+	if len(parts) <= depth {
+		return relDir, false
+	}
+
+	return strings.Join(parts[:depth], "/"), true
+}
+
+// vendorModulePath find go.mod module, that provide this import.
+// When module not found, import path itself is used
+func vendorModulePath(importPath string, requirements []string) string {
+	bestMatch := ""
+
+	for _, modulePath := range requirements {
+		if importPath != modulePath && !strings.HasPrefix(importPath, modulePath+"/") {
+			continue
+		}
+
+		if len(modulePath) > len(bestMatch) {
+			bestMatch = modulePath
+		}
+	}
+
+	if bestMatch == "" {
+		return importPath
+	}
+
+	return bestMatch
+}
+
+func assignComponentNames(components map[string]*draftComponent) {
+	keys := make([]string, 0, len(components))
+	for key := range components {
+		keys = append(keys, key)
+	}
+
+	names := uniqueNames(keys, func(key string) (string, string) {
+		if key == "." {
+			return "root", "root"
+		}
+
+		return lastPathSegment(key), strings.ReplaceAll(key, "/", "-")
+	})
+
+	for key, cmp := range components {
+		cmp.name = names[key]
+	}
+}
+
+func assignVendorNames(vendors map[string]*draftVendor) {
+	keys := make([]string, 0, len(vendors))
+	for key := range vendors {
+		keys = append(keys, key)
+	}
+
+	names := uniqueNames(keys, func(modulePath string) (string, string) {
+		return lastPathSegment(modulePath), strings.ReplaceAll(modulePath, "/", "-")
+	})
+
+	for key, vnd := range vendors {
+		vnd.name = names[key]
+	}
+}
+
+// uniqueNames will use short name for every key, when it's unique,
+// otherwise long name is used
+func uniqueNames(keys []string, naming func(key string) (short string, long string)) map[string]string {
+	usage := map[string]int{}
+	for _, key := range keys {
+		short, _ := naming(key)
+		usage[short]++
+	}
+
+	names := make(map[string]string, len(keys))
+	for _, key := range keys {
+		short, long := naming(key)
+		if usage[short] > 1 {
+			names[key] = long
+			continue
+		}
+
+		names[key] = short
+	}
+
+	return names
+}
+
+// lastPathSegment return last meaningful path segment,
+// major version suffixes (like "/v3") is skipped
+func lastPathSegment(path string) string {
+	parts := strings.Split(path, "/")
+	for i := len(parts) - 1; i > 0; i-- {
+		if isMajorVersionSuffix(parts[i]) {
+			continue
+		}
+
+		return parts[i]
+	}
+
+	return parts[0]
+}
+
+func isMajorVersionSuffix(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+
+	for _, ch := range segment[1:] {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (o *Operation) render(doc draft) string {
+	var buf strings.Builder
+
+	buf.WriteString("version: 3\n")
+	buf.WriteString("allow:\n")
+	buf.WriteString("  depOnAnyVendor: false\n")
+	buf.WriteString("  deepScan: false\n")
+
+	if len(doc.excludes) > 0 {
+		buf.WriteString("\nexclude:\n")
+		for _, exclude := range doc.excludes {
+			buf.WriteString(fmt.Sprintf("  - %s\n", exclude))
+		}
+	}
+
+	buf.WriteString("\nexcludeFiles:\n")
+	buf.WriteString(fmt.Sprintf("  - %q\n", excludeTestFilesRegExp))
+
+	vendors := sortedVendors(doc.vendors)
+	if len(vendors) > 0 {
+		buf.WriteString("\nvendors:\n")
+		for _, vnd := range vendors {
+			buf.WriteString(fmt.Sprintf("  %s:\n", vnd.name))
+			buf.WriteString("    in:\n")
+			buf.WriteString(fmt.Sprintf("      - %s\n", vnd.modulePath))
+			buf.WriteString(fmt.Sprintf("      - %s/**\n", vnd.modulePath))
+		}
+	}
+
+	components := sortedComponents(doc.components)
+	buf.WriteString("\ncomponents:\n")
+	for _, cmp := range components {
+		in := cmp.pathKey
+		if cmp.nested {
+			in += "/**"
+		}
+
+		buf.WriteString(fmt.Sprintf("  %s:\n", cmp.name))
+		buf.WriteString(fmt.Sprintf("    in: %s\n", in))
+	}
+
+	deps := strings.Builder{}
+	for _, cmp := range components {
+		if len(cmp.mayDependOn) == 0 && len(cmp.canUse) == 0 {
+			continue
+		}
+
+		deps.WriteString(fmt.Sprintf("  %s:\n", cmp.name))
+
+		if len(cmp.mayDependOn) > 0 {
+			deps.WriteString("    mayDependOn:\n")
+			for _, name := range namesOf(cmp.mayDependOn, func(key string) string { return doc.components[key].name }) {
+				deps.WriteString(fmt.Sprintf("      - %s\n", name))
+			}
+		}
+
+		if len(cmp.canUse) > 0 {
+			deps.WriteString("    canUse:\n")
+			for _, name := range namesOf(cmp.canUse, func(key string) string { return doc.vendors[key].name }) {
+				deps.WriteString(fmt.Sprintf("      - %s\n", name))
+			}
+		}
+	}
+
+	if deps.Len() == 0 {
+		buf.WriteString("\ndeps: {}\n")
+		return buf.String()
+	}
+
+	buf.WriteString("\ndeps:\n")
+	buf.WriteString(deps.String())
+	return buf.String()
+}
+
+func sortedComponents(components map[string]*draftComponent) []*draftComponent {
+	list := make([]*draftComponent, 0, len(components))
+	for _, cmp := range components {
+		list = append(list, cmp)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].name < list[j].name
+	})
+
+	return list
+}
+
+func sortedVendors(vendors map[string]*draftVendor) []*draftVendor {
+	list := make([]*draftVendor, 0, len(vendors))
+	for _, vnd := range vendors {
+		list = append(list, vnd)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].name < list[j].name
+	})
+
+	return list
+}
+
+func namesOf(keys map[string]struct{}, name func(key string) string) []string {
+	list := make([]string, 0, len(keys))
+	for key := range keys {
+		list = append(list, name(key))
+	}
+
+	sort.Strings(list)
+	return list
+}
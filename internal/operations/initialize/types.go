@@ -0,0 +1,26 @@
+package initialize
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ModuleInfo(rootDirectory string, archFilePath string) (common.Project, error)
+		ModuleRequirements(goModFilePath string) ([]string, error)
+	}
+
+	projectFilesScanner interface {
+		Scan(
+			ctx context.Context,
+			projectDirectory string,
+			moduleName string,
+			excludePaths []models.ResolvedPath,
+			excludeFileMatchers []*regexp.Regexp,
+		) ([]models.ProjectFile, error)
+	}
+)
@@ -3,6 +3,7 @@ package mapping
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
@@ -48,6 +49,7 @@ func (o *Operation) Behave(ctx context.Context, in models.CmdMappingIn) (models.
 		ModuleName:       spec.ModuleName.Value,
 		MappingGrouped:   assembleMappingByComponent(spec, projectFiles),
 		MappingList:      assembleMappingByFile(projectFiles),
+		SplitPackages:    assembleSplitPackages(projectFiles),
 		Scheme:           in.Scheme,
 	}, nil
 }
@@ -135,6 +137,26 @@ func assembleMappingByFile(projectFiles []models.FileHold) []models.CmdMappingOu
 	return mapping
 }
 
+// assembleSplitPackages find packages, which files
+// are attached to different components (by file globs)
+func assembleSplitPackages(projectFiles []models.FileHold) map[string]bool {
+	packageComponents := make(map[string]string)
+	split := make(map[string]bool)
+
+	for _, projectFile := range projectFiles {
+		packagePath := filepath.Dir(projectFile.File.Path)
+		name := componentName(projectFile.ComponentID)
+
+		if known, exist := packageComponents[packagePath]; exist && known != name {
+			split[packagePath] = true
+		}
+
+		packageComponents[packagePath] = name
+	}
+
+	return split
+}
+
 func componentName(id *string) string {
 	if id == nil {
 		return "[not attached]"
@@ -0,0 +1,61 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+type Operation struct {
+	projectInfoAssembler projectInfoAssembler
+	specMigrator         specMigrator
+	differ               differ
+}
+
+func NewOperation(
+	projectInfoAssembler projectInfoAssembler,
+	specMigrator specMigrator,
+	differ differ,
+) *Operation {
+	return &Operation{
+		projectInfoAssembler: projectInfoAssembler,
+		specMigrator:         specMigrator,
+		differ:               differ,
+	}
+}
+
+func (o *Operation) Behave(_ context.Context, in models.CmdMigrateIn) (models.CmdMigrateOut, error) {
+	projectInfo, err := o.projectInfoAssembler.ProjectInfo(in.ProjectPath, in.ArchFile)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to assemble project info: %w", err)
+	}
+
+	migration, err := o.specMigrator.Migrate(projectInfo.GoArchFilePath)
+	if err != nil {
+		return models.CmdMigrateOut{}, fmt.Errorf("failed to migrate archfile: %w", err)
+	}
+
+	hasChanges := migration.FromVersion != migration.ToVersion
+	if hasChanges && !in.DryRun {
+		stat, err := os.Stat(projectInfo.GoArchFilePath)
+		if err != nil {
+			return models.CmdMigrateOut{}, fmt.Errorf("failed stat archfile: %w", err)
+		}
+
+		err = os.WriteFile(projectInfo.GoArchFilePath, migration.Migrated, stat.Mode())
+		if err != nil {
+			return models.CmdMigrateOut{}, fmt.Errorf("failed write migrated archfile into '%s': %w", projectInfo.GoArchFilePath, err)
+		}
+	}
+
+	return models.CmdMigrateOut{
+		ArchFile:    projectInfo.GoArchFilePath,
+		FromVersion: migration.FromVersion,
+		ToVersion:   migration.ToVersion,
+		Changes:     migration.Changes,
+		Diff:        o.differ.Diff(migration.Source, migration.Migrated),
+		DryRun:      in.DryRun,
+	}, nil
+}
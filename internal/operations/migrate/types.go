@@ -0,0 +1,20 @@
+package migrate
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+type (
+	projectInfoAssembler interface {
+		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
+	}
+
+	specMigrator interface {
+		Migrate(archFilePath string) (models.Migration, error)
+	}
+
+	differ interface {
+		Diff(before, after []byte) []models.DiffLine
+	}
+)
@@ -1,7 +1,9 @@
 package selfInspect
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -9,23 +11,26 @@ import (
 
 type Operation struct {
 	specAssembler        specAssembler
+	specInspector        specInspector
 	projectInfoAssembler projectInfoAssembler
 	version              string
 }
 
 func NewOperation(
 	specAssembler specAssembler,
+	specInspector specInspector,
 	projectInfoAssembler projectInfoAssembler,
 	version string,
 ) *Operation {
 	return &Operation{
 		specAssembler:        specAssembler,
+		specInspector:        specInspector,
 		projectInfoAssembler: projectInfoAssembler,
 		version:              version,
 	}
 }
 
-func (o *Operation) Behave(in models.CmdSelfInspectIn) (models.CmdSelfInspectOut, error) {
+func (o *Operation) Behave(ctx context.Context, in models.CmdSelfInspectIn) (models.CmdSelfInspectOut, error) {
 	projectInfo, err := o.projectInfoAssembler.ProjectInfo(
 		in.ProjectPath,
 		in.ArchFile,
@@ -39,6 +44,16 @@ func (o *Operation) Behave(in models.CmdSelfInspectIn) (models.CmdSelfInspectOut
 		return models.CmdSelfInspectOut{}, fmt.Errorf("failed assemble spec: %w", err)
 	}
 
+	if len(spec.Integrity.DocumentNotices) == 0 {
+		// project code can be inspected only with valid spec
+		unused, err := o.specInspector.Inspect(ctx, spec, projectInfo.GoArchFilePath)
+		if err != nil {
+			return models.CmdSelfInspectOut{}, fmt.Errorf("failed inspect spec: %w", err)
+		}
+
+		spec.Integrity.Suggestions = append(spec.Integrity.Suggestions, unused...)
+	}
+
 	return models.CmdSelfInspectOut{
 		ModuleName:    projectInfo.ModuleName,
 		RootDirectory: projectInfo.Directory,
@@ -63,6 +78,25 @@ func (o *Operation) asAnnotations(list []arch.Notice) []models.CmdSelfInspectOut
 		annotations = append(annotations, o.asAnnotation(notice))
 	}
 
+	sort.SliceStable(annotations, func(i, j int) bool {
+		refI := annotations[i].Reference
+		refJ := annotations[j].Reference
+
+		if refI.File != refJ.File {
+			return refI.File < refJ.File
+		}
+
+		if refI.Line != refJ.Line {
+			return refI.Line < refJ.Line
+		}
+
+		if refI.Column != refJ.Column {
+			return refI.Column < refJ.Column
+		}
+
+		return annotations[i].Text < annotations[j].Text
+	})
+
 	return annotations
 }
 
@@ -1,6 +1,8 @@
 package selfInspect
 
 import (
+	"context"
+
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
@@ -10,6 +12,10 @@ type (
 		Assemble(prj common.Project) (arch.Spec, error)
 	}
 
+	specInspector interface {
+		Inspect(ctx context.Context, spec arch.Spec, archFilePath string) ([]arch.Notice, error)
+	}
+
 	projectInfoAssembler interface {
 		ProjectInfo(rootDirectory string, archFilePath string) (common.Project, error)
 	}
@@ -0,0 +1,296 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+const fileVersion = 1
+
+type (
+	Baseline struct{}
+
+	baselineFile struct {
+		Version  int            `json:"version"`
+		Warnings []baselineItem `json:"warnings"`
+	}
+
+	baselineItem struct {
+		Kind      models.BaselineKind `json:"kind"`
+		Component string              `json:"component,omitempty"`
+		File      string              `json:"file,omitempty"`
+		Target    string              `json:"target,omitempty"`
+		Via       string              `json:"via,omitempty"`
+	}
+)
+
+func NewBaseline() *Baseline {
+	return &Baseline{}
+}
+
+// Fingerprints convert check warnings to baseline entries
+func (b *Baseline) Fingerprints(rootDirectory string, result models.CheckResult) []models.BaselineEntry {
+	entries := make([]models.BaselineEntry, 0)
+
+	for _, warn := range result.DependencyWarnings {
+		entries = append(entries, dependencyEntry(warn))
+	}
+
+	for _, warn := range result.MatchWarnings {
+		entries = append(entries, matchEntry(warn))
+	}
+
+	for _, warn := range result.CapabilityWarnings {
+		entries = append(entries, capabilityEntry(warn))
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		entries = append(entries, symbolEntry(warn))
+	}
+
+	for _, warn := range result.LeakWarnings {
+		entries = append(entries, leakEntry(warn))
+	}
+
+	for _, warn := range result.OwnershipWarnings {
+		entries = append(entries, ownershipEntry(rootDirectory, warn))
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		entries = append(entries, deepscanEntry(rootDirectory, warn))
+	}
+
+	for _, warn := range result.CycleWarnings {
+		entries = append(entries, cycleEntry(warn))
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Fingerprint() < entries[j].Fingerprint()
+	})
+
+	return entries
+}
+
+// Filter remove all known (by baseline) warnings from result.
+// Baseline entries, that not found in result, returned as fixed
+func (b *Baseline) Filter(
+	rootDirectory string,
+	entries []models.BaselineEntry,
+	result models.CheckResult,
+) (filtered models.CheckResult, known int, fixed []models.BaselineEntry) {
+	// same warning can occur multiple times (for example same
+	// injection in different lines), so entries is counted
+	remaining := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		remaining[entry.Fingerprint()]++
+	}
+
+	isKnown := func(entry models.BaselineEntry) bool {
+		fingerprint := entry.Fingerprint()
+		if remaining[fingerprint] <= 0 {
+			return false
+		}
+
+		remaining[fingerprint]--
+		known++
+		return true
+	}
+
+	filtered = result
+	filtered.DependencyWarnings = []models.CheckArchWarningDependency{}
+	filtered.MatchWarnings = []models.CheckArchWarningMatch{}
+	filtered.CapabilityWarnings = []models.CheckArchWarningCapability{}
+	filtered.SymbolWarnings = []models.CheckArchWarningSymbol{}
+	filtered.LeakWarnings = []models.CheckArchWarningLeak{}
+	filtered.OwnershipWarnings = []models.CheckArchWarningOwnership{}
+	filtered.DeepscanWarnings = []models.CheckArchWarningDeepscan{}
+	filtered.CycleWarnings = []models.CheckArchWarningCycle{}
+
+	for _, warn := range result.DependencyWarnings {
+		if !isKnown(dependencyEntry(warn)) {
+			filtered.DependencyWarnings = append(filtered.DependencyWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.MatchWarnings {
+		if !isKnown(matchEntry(warn)) {
+			filtered.MatchWarnings = append(filtered.MatchWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.CapabilityWarnings {
+		if !isKnown(capabilityEntry(warn)) {
+			filtered.CapabilityWarnings = append(filtered.CapabilityWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		if !isKnown(symbolEntry(warn)) {
+			filtered.SymbolWarnings = append(filtered.SymbolWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.LeakWarnings {
+		if !isKnown(leakEntry(warn)) {
+			filtered.LeakWarnings = append(filtered.LeakWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.OwnershipWarnings {
+		if !isKnown(ownershipEntry(rootDirectory, warn)) {
+			filtered.OwnershipWarnings = append(filtered.OwnershipWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		if !isKnown(deepscanEntry(rootDirectory, warn)) {
+			filtered.DeepscanWarnings = append(filtered.DeepscanWarnings, warn)
+		}
+	}
+
+	for _, warn := range result.CycleWarnings {
+		if !isKnown(cycleEntry(warn)) {
+			filtered.CycleWarnings = append(filtered.CycleWarnings, warn)
+		}
+	}
+
+	fixed = make([]models.BaselineEntry, 0)
+	for _, entry := range entries {
+		fingerprint := entry.Fingerprint()
+		if remaining[fingerprint] <= 0 {
+			continue
+		}
+
+		remaining[fingerprint]--
+		fixed = append(fixed, entry)
+	}
+
+	return filtered, known, fixed
+}
+
+func (b *Baseline) Read(path string) ([]models.BaselineEntry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed read baseline: %w", err)
+	}
+
+	file := baselineFile{}
+	err = json.Unmarshal(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("failed parse baseline '%s': %w", path, err)
+	}
+
+	if file.Version != fileVersion {
+		return nil, fmt.Errorf("unsupported baseline version %d, expected %d (write baseline again)",
+			file.Version,
+			fileVersion,
+		)
+	}
+
+	entries := make([]models.BaselineEntry, 0, len(file.Warnings))
+	for _, item := range file.Warnings {
+		entries = append(entries, models.BaselineEntry(item))
+	}
+
+	return entries, nil
+}
+
+func (b *Baseline) Write(path string, entries []models.BaselineEntry) error {
+	items := make([]baselineItem, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, baselineItem(entry))
+	}
+
+	content, err := json.MarshalIndent(baselineFile{
+		Version:  fileVersion,
+		Warnings: items,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed marshal baseline: %w", err)
+	}
+
+	err = os.WriteFile(path, append(content, '\n'), 0o644)
+	if err != nil {
+		return fmt.Errorf("failed write baseline: %w", err)
+	}
+
+	return nil
+}
+
+func dependencyEntry(warn models.CheckArchWarningDependency) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindDependency,
+		Component: warn.ComponentName,
+		File:      warn.FileRelativePath,
+		Target:    warn.ResolvedImportName,
+	}
+}
+
+func matchEntry(warn models.CheckArchWarningMatch) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind: models.BaselineKindMatch,
+		File: warn.FileRelativePath,
+	}
+}
+
+func capabilityEntry(warn models.CheckArchWarningCapability) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindCapability,
+		Component: warn.ComponentName,
+		File:      warn.FileRelativePath,
+		Target:    warn.ResolvedImportName,
+		Via:       string(warn.Capability),
+	}
+}
+
+func symbolEntry(warn models.CheckArchWarningSymbol) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindSymbol,
+		Component: warn.ComponentName,
+		File:      warn.FileRelativePath,
+		Target:    warn.Symbol,
+		Via:       warn.Rule,
+	}
+}
+
+func leakEntry(warn models.CheckArchWarningLeak) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindLeak,
+		Component: warn.ComponentName,
+		File:      warn.FileRelativePath,
+		Target:    warn.LeakedType,
+		Via:       warn.Declaration,
+	}
+}
+
+func deepscanEntry(rootDirectory string, warn models.CheckArchWarningDeepscan) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindDeepscan,
+		Component: warn.Gate.ComponentName,
+		File:      strings.TrimPrefix(warn.Dependency.Injection.File, rootDirectory),
+		Target:    fmt.Sprintf("%s:%s", warn.Dependency.ComponentName, warn.Dependency.Name),
+		Via:       warn.Gate.MethodName,
+	}
+}
+
+func ownershipEntry(rootDirectory string, warn models.CheckArchWarningOwnership) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:      models.BaselineKindOwnership,
+		Component: warn.Gate.ComponentName,
+		File:      strings.TrimPrefix(warn.Gate.Definition.File, rootDirectory),
+		Target:    fmt.Sprintf("%s:%s", warn.Interface.ComponentName, warn.Interface.Name),
+		Via:       warn.Gate.MethodName,
+	}
+}
+
+func cycleEntry(warn models.CheckArchWarningCycle) models.BaselineEntry {
+	return models.BaselineEntry{
+		Kind:   models.BaselineKindCycle,
+		Target: strings.Join(warn.Path, " -> "),
+	}
+}
@@ -0,0 +1,115 @@
+package baseline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func TestBaseline_Filter(t *testing.T) {
+	const root = "/app"
+
+	depA := models.CheckArchWarningDependency{
+		ComponentName:      "domain",
+		FileRelativePath:   "/internal/domain/a.go",
+		ResolvedImportName: "example.com/app/internal/infra",
+		Reference:          common.NewReferenceSingleLine("/app/internal/domain/a.go", 5, 1),
+	}
+	depAMoved := depA
+	depAMoved.Reference = common.NewReferenceSingleLine("/app/internal/domain/a.go", 42, 1)
+
+	depB := models.CheckArchWarningDependency{
+		ComponentName:      "domain",
+		FileRelativePath:   "/internal/domain/b.go",
+		ResolvedImportName: "example.com/app/internal/infra",
+	}
+
+	deepscan := models.CheckArchWarningDeepscan{
+		Gate: models.DeepscanWarningGate{ComponentName: "operations", MethodName: "NewOperation"},
+		Dependency: models.DeepscanWarningDependency{
+			ComponentName: "repository",
+			Name:          "repository.Memory",
+			Injection:     common.NewReferenceSingleLine("/app/internal/di/di.go", 10, 1),
+		},
+	}
+	deepscanMoved := deepscan
+	deepscanMoved.Dependency.Injection = common.NewReferenceSingleLine("/app/internal/di/di.go", 20, 1)
+
+	ownership := models.CheckArchWarningOwnership{
+		Gate: models.DeepscanWarningGate{
+			ComponentName: "operations",
+			MethodName:    "NewOperation",
+			Definition:    common.NewReferenceSingleLine("/app/internal/operations/op.go", 10, 1),
+		},
+		Interface: models.DeepscanWarningInterface{ComponentName: "repository", Name: "repository.Finder"},
+	}
+	ownershipMoved := ownership
+	ownershipMoved.Gate.Definition = common.NewReferenceSingleLine("/app/internal/operations/op.go", 30, 1)
+
+	tests := []struct {
+		name      string
+		baseline  models.CheckResult
+		result    models.CheckResult
+		wantNew   models.CheckResult
+		wantKnown int
+		wantFixed int
+	}{
+		{
+			name:      "lines changed",
+			baseline:  models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depA}},
+			result:    models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depAMoved}},
+			wantNew:   models.CheckResult{},
+			wantKnown: 1,
+			wantFixed: 0,
+		},
+		{
+			name:      "new warning",
+			baseline:  models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depA}},
+			result:    models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depA, depB}},
+			wantNew:   models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depB}},
+			wantKnown: 1,
+			wantFixed: 0,
+		},
+		{
+			name:      "fixed warning",
+			baseline:  models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depA, depB}},
+			result:    models.CheckResult{DependencyWarnings: []models.CheckArchWarningDependency{depB}},
+			wantNew:   models.CheckResult{},
+			wantKnown: 1,
+			wantFixed: 1,
+		},
+		{
+			name:      "same injection added again",
+			baseline:  models.CheckResult{DeepscanWarnings: []models.CheckArchWarningDeepscan{deepscan}},
+			result:    models.CheckResult{DeepscanWarnings: []models.CheckArchWarningDeepscan{deepscan, deepscanMoved}},
+			wantNew:   models.CheckResult{DeepscanWarnings: []models.CheckArchWarningDeepscan{deepscanMoved}},
+			wantKnown: 1,
+			wantFixed: 0,
+		},
+		{
+			name:      "interface param moved",
+			baseline:  models.CheckResult{OwnershipWarnings: []models.CheckArchWarningOwnership{ownership}},
+			result:    models.CheckResult{OwnershipWarnings: []models.CheckArchWarningOwnership{ownershipMoved}},
+			wantNew:   models.CheckResult{},
+			wantKnown: 1,
+			wantFixed: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBaseline()
+			entries := b.Fingerprints(root, tt.baseline)
+
+			got, known, fixed := b.Filter(root, entries, tt.result)
+			assert.Equal(t, tt.wantKnown, known)
+			assert.Len(t, fixed, tt.wantFixed)
+			assert.ElementsMatch(t, tt.wantNew.DependencyWarnings, got.DependencyWarnings)
+			assert.ElementsMatch(t, tt.wantNew.DeepscanWarnings, got.DeepscanWarnings)
+			assert.ElementsMatch(t, tt.wantNew.OwnershipWarnings, got.OwnershipWarnings)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package checker
+
+import "github.com/fe3dback/go-arch-lint/internal/models"
+
+type (
+	capabilityCatalogueEntry struct {
+		glob       models.Glob
+		capability models.Capability
+	}
+)
+
+// capabilityCatalogue maps stdlib and well-known packages
+// to capabilities, that importer code will gain.
+var capabilityCatalogue = []capabilityCatalogueEntry{
+	// network
+	{glob: "net", capability: models.CapabilityNetwork},
+	{glob: "net/**", capability: models.CapabilityNetwork},
+	{glob: "crypto/tls", capability: models.CapabilityNetwork},
+	{glob: "golang.org/x/net/**", capability: models.CapabilityNetwork},
+	{glob: "google.golang.org/grpc", capability: models.CapabilityNetwork},
+	{glob: "google.golang.org/grpc/**", capability: models.CapabilityNetwork},
+
+	// filesystem
+	// path/filepath and os/user are not here: first one only
+	// manipulate path strings, second one only lookup users
+	{glob: "os", capability: models.CapabilityFilesystem},
+	{glob: "io/fs", capability: models.CapabilityFilesystem},
+	{glob: "io/ioutil", capability: models.CapabilityFilesystem},
+
+	// exec
+	{glob: "os/exec", capability: models.CapabilityExec},
+	{glob: "plugin", capability: models.CapabilityExec},
+
+	// unsafe
+	{glob: "unsafe", capability: models.CapabilityUnsafe},
+
+	// cgo
+	{glob: "C", capability: models.CapabilityCGO},
+	{glob: "runtime/cgo", capability: models.CapabilityCGO},
+
+	// reflect
+	{glob: "reflect", capability: models.CapabilityReflect},
+
+	// syscall
+	{glob: "syscall", capability: models.CapabilitySyscall},
+	{glob: "syscall/**", capability: models.CapabilitySyscall},
+	{glob: "os/signal", capability: models.CapabilitySyscall},
+	{glob: "golang.org/x/sys/**", capability: models.CapabilitySyscall},
+}
+
+// resolveCapabilities return list of capabilities, that
+// code will gain after import of package with importPath
+func resolveCapabilities(importPath string) ([]models.Capability, error) {
+	capabilities := make([]models.Capability, 0)
+
+	for _, entry := range capabilityCatalogue {
+		matched, err := entry.glob.Match(importPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if matched {
+			capabilities = append(capabilities, entry.capability)
+		}
+	}
+
+	return capabilities, nil
+}
@@ -0,0 +1,39 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+func Test_resolveCapabilities(t *testing.T) {
+	tests := []struct {
+		importPath string
+		want       []models.Capability
+	}{
+		{importPath: "fmt", want: []models.Capability{}},
+		{importPath: "net", want: []models.Capability{models.CapabilityNetwork}},
+		{importPath: "net/http/httptest", want: []models.Capability{models.CapabilityNetwork}},
+		{importPath: "os", want: []models.Capability{models.CapabilityFilesystem}},
+		{importPath: "os/user", want: []models.Capability{}},
+		{importPath: "os/signal", want: []models.Capability{models.CapabilitySyscall}},
+		{importPath: "path/filepath", want: []models.Capability{}},
+		{importPath: "os/exec", want: []models.Capability{models.CapabilityExec}},
+		{importPath: "unsafe", want: []models.Capability{models.CapabilityUnsafe}},
+		{importPath: "C", want: []models.Capability{models.CapabilityCGO}},
+		{importPath: "reflect", want: []models.Capability{models.CapabilityReflect}},
+		{importPath: "syscall", want: []models.Capability{models.CapabilitySyscall}},
+		{importPath: "golang.org/x/sys/unix", want: []models.Capability{models.CapabilitySyscall}},
+		{importPath: "github.com/vendor/network", want: []models.Capability{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importPath, func(t *testing.T) {
+			got, err := resolveCapabilities(tt.importPath)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
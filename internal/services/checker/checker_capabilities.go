@@ -0,0 +1,105 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+type Capabilities struct {
+	spec                 arch.Spec
+	projectFilesResolver projectFilesResolver
+	result               results
+}
+
+func NewCapabilities(
+	projectFilesResolver projectFilesResolver,
+) *Capabilities {
+	return &Capabilities{
+		projectFilesResolver: projectFilesResolver,
+	}
+}
+
+func (c *Capabilities) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	c.spec = spec
+	c.result = newResults()
+
+	components := make(map[string]arch.Component)
+	for _, component := range spec.Components {
+		if !component.Capabilities.Restricted {
+			continue
+		}
+
+		components[component.Name.Value] = component
+	}
+
+	if len(components) == 0 {
+		return c.result.assembleSortedResults(), nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		component, ok := components[*projectFile.ComponentID]
+		if !ok {
+			continue
+		}
+
+		err := c.checkFile(component, projectFile.File)
+		if err != nil {
+			return models.CheckResult{}, fmt.Errorf("failed check file '%s': %w", projectFile.File.Path, err)
+		}
+	}
+
+	return c.result.assembleSortedResults(), nil
+}
+
+func (c *Capabilities) checkFile(component arch.Component, file models.ProjectFile) error {
+	for _, resolvedImport := range file.Imports {
+		capabilities, err := resolveCapabilities(resolvedImport.Name)
+		if err != nil {
+			return fmt.Errorf("failed resolve capabilities of import '%s': %w", resolvedImport.Name, err)
+		}
+
+		for _, capability := range capabilities {
+			if capabilityAllowed(component, capability) {
+				continue
+			}
+
+			c.result.addCapabilityWarning(models.CheckArchWarningCapability{
+				ComponentName:      component.Name.Value,
+				Capability:         capability,
+				FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+				FileAbsolutePath:   file.Path,
+				ResolvedImportName: resolvedImport.Name,
+				Reference:          resolvedImport.Reference,
+			})
+		}
+	}
+
+	return nil
+}
+
+func capabilityAllowed(component arch.Component, capability models.Capability) bool {
+	if !component.Capabilities.Restricted {
+		return true
+	}
+
+	for _, allowed := range component.Capabilities.Allowed {
+		if allowed.Value == capability {
+			return true
+		}
+	}
+
+	return false
+}
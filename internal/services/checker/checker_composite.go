@@ -9,14 +9,34 @@ import (
 )
 
 type CompositeChecker struct {
-	checkers []checker
+	projectFilesResolver projectFilesResolver
+	checkers             []checker
 }
 
-func NewCompositeChecker(checkers ...checker) *CompositeChecker {
-	return &CompositeChecker{checkers: checkers}
+func NewCompositeChecker(projectFilesResolver projectFilesResolver, checkers ...checker) *CompositeChecker {
+	return &CompositeChecker{
+		projectFilesResolver: projectFilesResolver,
+		checkers:             checkers,
+	}
 }
 
+// Check run checkers one by one, until first checker with notices
 func (c *CompositeChecker) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	return c.check(ctx, spec, true)
+}
+
+// CheckAll run all checkers, even when previous checkers has notices
+func (c *CompositeChecker) CheckAll(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	return c.check(ctx, spec, false)
+}
+
+func (c *CompositeChecker) check(ctx context.Context, spec arch.Spec, stopOnNotices bool) (models.CheckResult, error) {
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	suppressor := newSuppressor(spec, projectFiles)
 	overallResults := models.CheckResult{}
 
 	for ind, checker := range c.checkers {
@@ -25,12 +45,15 @@ func (c *CompositeChecker) Check(ctx context.Context, spec arch.Spec) (models.Ch
 			return models.CheckResult{}, fmt.Errorf("checker failed '%T': %w", checker, err)
 		}
 
+		results = suppressor.suppress(results)
 		overallResults.Append(results)
 
-		if results.HasNotices() && ind < len(c.checkers)-1 {
+		if stopOnNotices && results.HasNotices() && ind < len(c.checkers)-1 {
 			break
 		}
 	}
 
+	overallResults.Suppressions = suppressor.assembleSuppressions()
+	overallResults.Overlaps = assembleOverlaps(spec, projectFiles)
 	return overallResults, nil
 }
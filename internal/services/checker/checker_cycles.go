@@ -0,0 +1,103 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/cycles"
+)
+
+// Cycles find cycles in real (code) dependency graph between components.
+// Graph built only from project imports, so when spec allow cycle
+// (a mayDependOn b, b mayDependOn a), this checker still report
+// it, if cycle really exist in code. Checker is opt-in (allow.cycles = false)
+type Cycles struct {
+	projectFilesResolver projectFilesResolver
+}
+
+func NewCycles(
+	projectFilesResolver projectFilesResolver,
+) *Cycles {
+	return &Cycles{
+		projectFilesResolver: projectFilesResolver,
+	}
+}
+
+func (c *Cycles) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	if spec.Allow.Cycles.Value {
+		return models.CheckResult{}, nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	sort.Slice(projectFiles, func(i, j int) bool {
+		return projectFiles[i].File.Path < projectFiles[j].File.Path
+	})
+
+	// package directory -> component
+	packageComponents := models.PackageComponents(projectFiles)
+
+	graph := make(map[string][]string)
+	usages := make(map[string]map[string]models.CycleEdgeUsage)
+
+	for _, hold := range projectFiles {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		from := *hold.ComponentID
+		for _, resolvedImport := range hold.File.Imports {
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				continue
+			}
+
+			to, ok := packageComponents[importDirectory(spec, resolvedImport.Name)]
+			if !ok || to == from {
+				continue
+			}
+
+			if _, ok := usages[from]; !ok {
+				usages[from] = make(map[string]models.CycleEdgeUsage)
+			}
+
+			if _, exist := usages[from][to]; exist {
+				// first usage is enough for example
+				continue
+			}
+
+			graph[from] = append(graph[from], to)
+			usages[from][to] = models.CycleEdgeUsage{
+				From:               from,
+				To:                 to,
+				FileRelativePath:   strings.TrimPrefix(hold.File.Path, spec.RootDirectory.Value),
+				FileAbsolutePath:   hold.File.Path,
+				ResolvedImportName: resolvedImport.Name,
+				Reference:          resolvedImport.Reference,
+			}
+		}
+	}
+
+	warnings := make([]models.CheckArchWarningCycle, 0)
+	for _, cycle := range cycles.Find(graph) {
+		edges := make([]models.CycleEdgeUsage, 0, len(cycle)-1)
+		for ind := 0; ind < len(cycle)-1; ind++ {
+			edges = append(edges, usages[cycle[ind]][cycle[ind+1]])
+		}
+
+		warnings = append(warnings, models.CheckArchWarningCycle{
+			Path:  cycle,
+			Edges: edges,
+		})
+	}
+
+	return models.CheckResult{
+		CycleWarnings: warnings,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -25,6 +26,7 @@ type DeepScan struct {
 	result            models.CheckResult
 	fileComponents    map[string]string
 	packageComponents map[string]string
+	commonComponents  map[string]bool
 
 	sync.Mutex
 }
@@ -85,7 +87,6 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 	}
 
 	c.fileComponents = map[string]string{}
-	c.packageComponents = map[string]string{}
 
 	for _, hold := range mapping {
 		if hold.ComponentID == nil {
@@ -94,10 +95,16 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 
 		// cache file -> component ref
 		c.fileComponents[hold.File.Path] = *hold.ComponentID
+	}
+
+	// cache package -> component ref
+	c.packageComponents = models.PackageComponents(mapping)
+
+	// cache common components, interfaces can be owned by them
+	c.commonComponents = map[string]bool{}
 
-		// cache package -> component ref
-		packagePath := filepath.Dir(hold.File.Path)
-		c.packageComponents[packagePath] = *hold.ComponentID
+	for _, component := range spec.Components {
+		c.commonComponents[component.Name.Value] = component.Common
 	}
 
 	// -- scan project
@@ -134,12 +141,34 @@ func (c *DeepScan) Check(ctx context.Context, spec arch.Spec) (models.CheckResul
 		return models.CheckResult{}, err
 	}
 
+	sort.Slice(c.result.OwnershipWarnings, func(i, j int) bool {
+		a, b := c.result.OwnershipWarnings[i].Gate, c.result.OwnershipWarnings[j].Gate
+		if a.Definition.File == b.Definition.File {
+			return a.Definition.Line < b.Definition.Line
+		}
+
+		return a.Definition.File < b.Definition.File
+	})
+
 	return c.result, nil
 }
 
 func (c *DeepScan) checkComponent(ctx context.Context, cmp arch.Component) error {
+	scanned := make(map[string]struct{})
+
 	for _, packagePath := range cmp.ResolvedPaths {
 		absPath := packagePath.Value.AbsPath
+		if packagePath.Value.File {
+			// package split by file globs is scanned with package owner
+			absPath = filepath.Dir(absPath)
+		}
+
+		if _, ok := scanned[absPath]; ok {
+			continue
+		}
+
+		scanned[absPath] = struct{}{}
+
 		matchedCmp, ok := c.packageComponents[absPath]
 		if !ok {
 			// component in excludes list
@@ -190,6 +219,10 @@ func (c *DeepScan) scanPackage(ctx context.Context, cmp *arch.Component, absPack
 
 func (c *DeepScan) checkUsage(ctx context.Context, cmp *arch.Component, usage *deepscan.InjectionMethod) error {
 	for _, gate := range usage.Gates {
+		if c.spec.Allow.OwnInterfaces.Value && gate.Kind != deepscan.GateKindFunc {
+			c.checkInterfaceOwner(cmp, &gate)
+		}
+
 		if len(gate.Implementations) == 0 {
 			continue
 		}
@@ -206,6 +239,46 @@ func (c *DeepScan) checkUsage(ctx context.Context, cmp *arch.Component, usage *d
 	return nil
 }
 
+// checkInterfaceOwner add warning, when method accept interface, declared in
+// other component (dependency inversion done backwards: consumer depend on
+// interface of provider). Interfaces from common components are allowed
+func (c *DeepScan) checkInterfaceOwner(cmp *arch.Component, gate *deepscan.Gate) {
+	definition := gate.Type.Definition
+	ownerComponentID, ownerDefined := c.fileComponents[definition.Place.File]
+
+	if !ownerDefined {
+		// stdlib or vendor interface (io.Reader, etc...)
+		return
+	}
+
+	if ownerComponentID == cmp.Name.Value || c.commonComponents[ownerComponentID] {
+		return
+	}
+
+	warn := models.CheckArchWarningOwnership{
+		Gate: models.DeepscanWarningGate{
+			ComponentName: cmp.Name.Value,
+			MethodName:    gate.MethodName,
+			RelativePath:  c.definitionToRelPath(gate.ArgumentDefinition.Place),
+			Definition:    gate.ArgumentDefinition.Place,
+		},
+		Interface: models.DeepscanWarningInterface{
+			ComponentName: ownerComponentID,
+			Name:          fmt.Sprintf("%s.%s", definition.Pkg, gate.Type.Name),
+			ImportPath:    definition.Import,
+			Definition:    definition.Place,
+			RelativePath:  c.definitionToRelPath(definition.Place),
+		},
+		SourceCodePreview: c.renderCode(
+			gate.ArgumentDefinition.Place,
+			gate.MethodDefinition.Place,
+			gate.ArgumentDefinition.Place,
+		),
+	}
+
+	c.result.OwnershipWarnings = append(c.result.OwnershipWarnings, warn)
+}
+
 func (c *DeepScan) checkGate(_ context.Context, cmp *arch.Component, gate *deepscan.Gate) error {
 	for _, implementation := range gate.Implementations {
 		err := c.checkImplementation(cmp, gate, &implementation)
@@ -227,9 +300,12 @@ func (c *DeepScan) checkImplementation(
 ) error {
 	injectedImport := imp.Target.Definition.Import
 
-	for _, allowedImport := range cmp.AllowedProjectImports {
-		if allowedImport.Value.ImportPath == injectedImport {
-			return nil
+	denyRule := checkProjectImportDenied(*cmp, injectedImport)
+	if denyRule == nil {
+		for _, allowedImport := range cmp.AllowedProjectImports {
+			if allowedImport.Value.ImportPath == injectedImport {
+				return nil
+			}
 		}
 	}
 
@@ -262,6 +338,7 @@ func (c *DeepScan) checkImplementation(
 				imp.Target.Definition.Pkg,
 				imp.Target.StructName,
 			),
+			ImportPath:    injectedImport,
 			InjectionAST:  imp.Injector.CodeName,
 			Injection:     imp.Injector.ParamDefinition.Place,
 			InjectionPath: c.definitionToRelPath(imp.Injector.ParamDefinition.Place),
@@ -275,6 +352,7 @@ func (c *DeepScan) checkImplementation(
 			Definition:   imp.Target.Definition.Place,
 			RelativePath: c.definitionToRelPath(imp.Target.Definition.Place),
 		},
+		DenyRule: denyRule,
 	}
 
 	c.result.DeepscanWarnings = append(c.result.DeepscanWarnings, warn)
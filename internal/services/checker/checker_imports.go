@@ -3,6 +3,7 @@ package checker
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
@@ -12,6 +13,9 @@ import (
 
 type Imports struct {
 	spec                 arch.Spec
+	notExported          map[string]common.Referable[string]
+	packageComponents    map[string]string // package directory -> owner component
+	componentLayers      map[string]int
 	projectFilesResolver projectFilesResolver
 	result               results
 }
@@ -34,6 +38,9 @@ func (c *Imports) Check(ctx context.Context, spec arch.Spec) (models.CheckResult
 	}
 
 	components := c.assembleComponentsMap(spec)
+	c.packageComponents = models.PackageComponents(projectFiles)
+	c.notExported = c.assembleNotExportedMap(spec)
+	c.componentLayers = c.assembleComponentLayersMap(spec)
 
 	for _, projectFile := range projectFiles {
 		if projectFile.ComponentID == nil {
@@ -72,9 +79,91 @@ func (c *Imports) assembleComponentsMap(spec arch.Spec) map[string]arch.Componen
 	return results
 }
 
+// assembleNotExportedMap return map of all not exported packages
+// import path -> name of component, that own this package.
+// Packages matched by several components belong only to owner component
+func (c *Imports) assembleNotExportedMap(spec arch.Spec) map[string]common.Referable[string] {
+	results := make(map[string]common.Referable[string])
+
+	for _, component := range spec.Components {
+		if !component.Exports.Restricted {
+			continue
+		}
+
+		exported := make(map[string]struct{}, len(component.Exports.Exported))
+		for _, exportedPath := range component.Exports.Exported {
+			exported[exportedPath.Value.ImportPath] = struct{}{}
+		}
+
+		for _, resolvedPath := range component.ResolvedPaths {
+			if _, ok := exported[resolvedPath.Value.ImportPath]; ok {
+				continue
+			}
+
+			if c.packageOwner(resolvedPath.Value.ImportPath) != component.Name.Value {
+				continue
+			}
+
+			results[resolvedPath.Value.ImportPath] = component.Name
+		}
+	}
+
+	return results
+}
+
+// importDirectory return absolute directory of project package by import path
+func importDirectory(spec arch.Spec, importPath string) string {
+	relativePath := strings.TrimPrefix(importPath, spec.ModuleName.Value)
+	return filepath.Join(spec.RootDirectory.Value, relativePath)
+}
+
+// packageOwner return name of component, that own project package
+// by import path (or empty string, when package not owned by any component)
+func (c *Imports) packageOwner(importPath string) string {
+	return c.packageComponents[importDirectory(c.spec, importPath)]
+}
+
+func (c *Imports) assembleComponentLayersMap(spec arch.Spec) map[string]int {
+	results := make(map[string]int)
+
+	for ind, layer := range spec.Layers.Layers {
+		for _, componentName := range layer.Components {
+			results[componentName] = ind
+		}
+	}
+
+	return results
+}
+
 func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) error {
 	for _, resolvedImport := range file.Imports {
-		allowed, err := checkImport(component, resolvedImport, c.spec.Allow.DepOnAnyVendor.Value)
+		if isolationRule := checkProjectImportIsolated(component, resolvedImport); isolationRule != nil {
+			c.result.addDependencyWarning(models.CheckArchWarningDependency{
+				Reference:          resolvedImport.Reference,
+				ComponentName:      component.Name.Value,
+				FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+				FileAbsolutePath:   file.Path,
+				ResolvedImportName: resolvedImport.Name,
+				IsolationRule:      isolationRule,
+			})
+
+			continue
+		}
+
+		if exportRule := checkProjectImportExported(component, resolvedImport, c.notExported); exportRule != nil {
+			c.result.addDependencyWarning(models.CheckArchWarningDependency{
+				Reference:          resolvedImport.Reference,
+				ComponentName:      component.Name.Value,
+				FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+				FileAbsolutePath:   file.Path,
+				ResolvedImportName: resolvedImport.Name,
+				ExportRule:         exportRule,
+			})
+
+			continue
+		}
+
+		allowed, denyRule, err := checkImport(component, resolvedImport, c.spec.Allow)
 		if err != nil {
 			return fmt.Errorf("failed check import '%s': %w",
 				resolvedImport.Name,
@@ -86,53 +175,224 @@ func (c *Imports) checkFile(component arch.Component, file models.ProjectFile) e
 			continue
 		}
 
+		var layerRule *models.LayerRule
+		if denyRule == nil && resolvedImport.ImportType == models.ImportTypeProject {
+			layerRule = c.checkLayers(component, c.packageOwner(resolvedImport.Name))
+		}
+
 		c.result.addDependencyWarning(models.CheckArchWarningDependency{
 			Reference:          resolvedImport.Reference,
 			ComponentName:      component.Name.Value,
 			FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
 			FileAbsolutePath:   file.Path,
 			ResolvedImportName: resolvedImport.Name,
+			DenyRule:           denyRule,
+			LayerRule:          layerRule,
 		})
 	}
 
 	return nil
 }
 
+// checkLayers will return layer rule, when not allowed import
+// is violation of layers order (import of upper layer, or
+// import of not next layer for strict layers)
+func (c *Imports) checkLayers(component arch.Component, importedComponent string) *models.LayerRule {
+	from, ok := c.componentLayers[component.Name.Value]
+	if !ok {
+		return nil
+	}
+
+	to, ok := c.componentLayers[importedComponent]
+	if !ok || to == from {
+		return nil
+	}
+
+	layers := c.spec.Layers.Layers
+	rule := &models.LayerRule{
+		Layer:      layers[to].Name.Value,
+		ImportedBy: layers[from].Name.Value,
+		Reference:  layers[to].Name.Reference,
+	}
+
+	if to < from {
+		return rule
+	}
+
+	if c.spec.Layers.Strict.Value && to > from+1 {
+		rule.Next = layers[from+1].Name.Value
+		return rule
+	}
+
+	return nil
+}
+
+// checkImport will return false, when import is not allowed for component
+// in case when import explicitly rejected by deny rule, this rule also returned
 func checkImport(
 	component arch.Component,
 	resolvedImport models.ResolvedImport,
-	allowDependOnAnyVendor bool,
-) (bool, error) {
+	allow arch.Allow,
+) (bool, *models.DependencyDenyRule, error) {
 	switch resolvedImport.ImportType {
 	case models.ImportTypeStdLib:
-		return true, nil
+		denyRule, err := checkPackageImportDenied(component.DeniedStdlibImports, resolvedImport)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if denyRule != nil {
+			return false, denyRule, nil
+		}
+
+		if allow.DepOnAnyStdlib.Value {
+			return true, nil, nil
+		}
+
+		allowed, err := checkStdlibImport(component, resolvedImport)
+		return allowed, nil, err
 	case models.ImportTypeVendor:
-		if allowDependOnAnyVendor {
-			return true, nil
+		denyRule, err := checkPackageImportDenied(component.DeniedVendorImports, resolvedImport)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if denyRule != nil {
+			return false, denyRule, nil
 		}
 
-		return checkVendorImport(component, resolvedImport)
+		if allow.DepOnAnyVendor.Value {
+			return true, nil, nil
+		}
+
+		allowed, err := checkVendorImport(component, resolvedImport)
+		return allowed, nil, err
 	case models.ImportTypeProject:
-		return checkProjectImport(component, resolvedImport), nil
+		if denyRule := checkProjectImportDenied(component, resolvedImport.Name); denyRule != nil {
+			return false, denyRule, nil
+		}
+
+		return checkProjectImport(component, resolvedImport), nil, nil
 	default:
 		panic(fmt.Sprintf("unknown import type: %+v", resolvedImport))
 	}
 }
 
+func checkPackageImportDenied(rules []arch.DenyPackageRule, resolvedImport models.ResolvedImport) (*models.DependencyDenyRule, error) {
+	for _, rule := range rules {
+		matched, err := models.MatchGlobs(rule.Globs, resolvedImport.Name)
+		if err != nil {
+			return nil, models.NewReferableErr(
+				fmt.Errorf("invalid package globs of '%s': %w",
+					rule.Name.Value,
+					err,
+				),
+				rule.Name.Reference,
+			)
+		}
+
+		if matched {
+			return &models.DependencyDenyRule{
+				Section:   "cannotUse",
+				Name:      rule.Name.Value,
+				Reference: rule.Name.Reference,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func checkProjectImportDenied(component arch.Component, importPath string) *models.DependencyDenyRule {
+	for _, rule := range component.DeniedProjectImports {
+		for _, deniedPath := range rule.ResolvedPaths {
+			if deniedPath.ImportPath == importPath {
+				return &models.DependencyDenyRule{
+					Section:   "mayNotDependOn",
+					Name:      rule.ComponentName.Value,
+					Reference: rule.ComponentName.Reference,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkProjectImportIsolated will return isolation rule, when import
+// is not public API package of other component from isolated group
+func checkProjectImportIsolated(component arch.Component, resolvedImport models.ResolvedImport) *models.IsolationRule {
+	if resolvedImport.ImportType != models.ImportTypeProject {
+		return nil
+	}
+
+	for _, rule := range component.IsolatedImports {
+		for _, isolatedPath := range rule.ResolvedPaths {
+			if isolatedPath.ImportPath == resolvedImport.Name {
+				return &models.IsolationRule{
+					Group:     rule.Group.Value,
+					Component: rule.ComponentName,
+					PublicAPI: rule.PublicAPI.Value,
+					Reference: rule.Group.Reference,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkProjectImportExported will return export rule, when import
+// is not exported package of other component
+func checkProjectImportExported(
+	component arch.Component,
+	resolvedImport models.ResolvedImport,
+	notExported map[string]common.Referable[string],
+) *models.ExportRule {
+	if resolvedImport.ImportType != models.ImportTypeProject {
+		return nil
+	}
+
+	owner, ok := notExported[resolvedImport.Name]
+	if !ok || owner.Value == component.Name.Value {
+		return nil
+	}
+
+	return &models.ExportRule{
+		Component: owner.Value,
+		Reference: owner.Reference,
+	}
+}
+
 func checkVendorImport(component arch.Component, resolvedImport models.ResolvedImport) (bool, error) {
 	if component.SpecialFlags.AllowAllVendorDeps.Value {
 		return true, nil
 	}
 
-	for _, vendorGlob := range component.AllowedVendorGlobs {
-		matched, err := vendorGlob.Value.Match(resolvedImport.Name)
+	for _, vendorGlobs := range component.AllowedVendorGlobs {
+		matched, err := models.MatchGlobs(vendorGlobs.Value, resolvedImport.Name)
 		if err != nil {
 			return false, models.NewReferableErr(
-				fmt.Errorf("invalid vendor glob '%s': %w",
-					string(vendorGlob.Value),
-					err,
-				),
-				vendorGlob.Reference,
+				fmt.Errorf("invalid vendor globs: %w", err),
+				vendorGlobs.Reference,
+			)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func checkStdlibImport(component arch.Component, resolvedImport models.ResolvedImport) (bool, error) {
+	for _, stdlibGlobs := range component.AllowedStdlibGlobs {
+		matched, err := models.MatchGlobs(stdlibGlobs.Value, resolvedImport.Name)
+		if err != nil {
+			return false, models.NewReferableErr(
+				fmt.Errorf("invalid stdlib globs: %w", err),
+				stdlibGlobs.Reference,
 			)
 		}
 
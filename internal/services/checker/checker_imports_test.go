@@ -29,6 +29,13 @@ func makeBool(b bool) common.Referable[bool] {
 	return common.NewReferable(b, common.NewEmptyReference())
 }
 
+func makeAllow(dependOnAnyVendor, dependOnAnyStdlib bool) arch.Allow {
+	return arch.Allow{
+		DepOnAnyVendor: makeBool(dependOnAnyVendor),
+		DepOnAnyStdlib: makeBool(dependOnAnyStdlib),
+	}
+}
+
 func makeTestResolvedPath(localPath string) common.Referable[models.ResolvedPath] {
 	return common.NewReferable(
 		models.ResolvedPath{
@@ -55,8 +62,12 @@ func makeTestResolvedVendorImport(localPath string) models.ResolvedImport {
 }
 
 func makeTestResolvedStdlibImport() models.ResolvedImport {
+	return makeTestResolvedStdlibImportNamed("fmt")
+}
+
+func makeTestResolvedStdlibImportNamed(name string) models.ResolvedImport {
 	return models.ResolvedImport{
-		Name:       "fmt",
+		Name:       name,
 		ImportType: models.ImportTypeStdLib,
 	}
 }
@@ -334,6 +345,7 @@ func TestChecker_checkImport(t *testing.T) {
 	type args struct {
 		resolvedImport    models.ResolvedImport
 		dependOnAnyVendor bool
+		dependOnAnyStdlib bool
 	}
 	tests := []struct {
 		name string
@@ -365,10 +377,11 @@ func TestChecker_checkImport(t *testing.T) {
 			want: false,
 		},
 		{
-			name: "stdlib always ok",
+			name: "stdlib ok by default",
 			args: args{
 				resolvedImport:    makeTestResolvedStdlibImport(),
 				dependOnAnyVendor: false,
+				dependOnAnyStdlib: true,
 			},
 			want: true,
 		},
@@ -401,7 +414,7 @@ func TestChecker_checkImport(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := checkImport(cmp, tt.args.resolvedImport, tt.args.dependOnAnyVendor)
+			got, _, err := checkImport(cmp, tt.args.resolvedImport, makeAllow(tt.args.dependOnAnyVendor, tt.args.dependOnAnyStdlib))
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
@@ -419,6 +432,370 @@ func TestChecker_checkImport(t *testing.T) {
 			ImportType: 100,
 		}
 
-		_, _ = checkImport(cmp, resolvedImport, false)
+		_, _, _ = checkImport(cmp, resolvedImport, makeAllow(false, false))
 	})
 }
+
+func TestChecker_checkImportDenied(t *testing.T) {
+	denyRef := common.NewReferenceSingleLine("/app/.go-arch-lint.yml", 42, 8)
+
+	cmp := arch.Component{
+		Name: common.NewReferable("component", common.NewEmptyReference()),
+		SpecialFlags: arch.SpecialFlags{
+			AllowAllProjectDeps: makeBool(true),
+			AllowAllVendorDeps:  makeBool(true),
+		},
+		AllowedProjectImports: []common.Referable[models.ResolvedPath]{
+			makeTestResolvedPath("needle"),
+		},
+		DeniedProjectImports: []arch.DenyProjectRule{
+			{
+				ComponentName: common.NewReferable("repository", denyRef),
+				ResolvedPaths: []models.ResolvedPath{makeTestResolvedPath("needle").Value},
+			},
+		},
+		DeniedVendorImports: []arch.DenyPackageRule{
+			{
+				Name:  common.NewReferable("sql-drivers", denyRef),
+				Globs: []models.Glob{"github.com/vendor/lib/sql/**"},
+			},
+		},
+		DeniedStdlibImports: []arch.DenyPackageRule{
+			{
+				Name:  common.NewReferable("exec", denyRef),
+				Globs: []models.Glob{"os/exec"},
+			},
+		},
+	}
+
+	type args struct {
+		resolvedImport    models.ResolvedImport
+		dependOnAnyVendor bool
+		dependOnAnyStdlib bool
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         bool
+		wantDenyRule *models.DependencyDenyRule
+	}{
+		{
+			name: "project deny takes precedence over allow",
+			args: args{
+				resolvedImport: makeTestResolvedProjectImport("needle"),
+			},
+			want: false,
+			wantDenyRule: &models.DependencyDenyRule{
+				Section:   "mayNotDependOn",
+				Name:      "repository",
+				Reference: denyRef,
+			},
+		},
+		{
+			name: "project not denied",
+			args: args{
+				resolvedImport: makeTestResolvedProjectImport("other"),
+			},
+			want:         true,
+			wantDenyRule: nil,
+		},
+		{
+			name: "vendor deny takes precedence over any vendor",
+			args: args{
+				resolvedImport:    makeTestResolvedVendorImport("sql/postgres"),
+				dependOnAnyVendor: true,
+			},
+			want: false,
+			wantDenyRule: &models.DependencyDenyRule{
+				Section:   "cannotUse",
+				Name:      "sql-drivers",
+				Reference: denyRef,
+			},
+		},
+		{
+			name: "vendor not denied",
+			args: args{
+				resolvedImport: makeTestResolvedVendorImport("http"),
+			},
+			want:         true,
+			wantDenyRule: nil,
+		},
+		{
+			name: "stdlib deny takes precedence over any stdlib",
+			args: args{
+				resolvedImport:    makeTestResolvedStdlibImportNamed("os/exec"),
+				dependOnAnyStdlib: true,
+			},
+			want: false,
+			wantDenyRule: &models.DependencyDenyRule{
+				Section:   "cannotUse",
+				Name:      "exec",
+				Reference: denyRef,
+			},
+		},
+		{
+			name: "stdlib not denied",
+			args: args{
+				resolvedImport:    makeTestResolvedStdlibImportNamed("os"),
+				dependOnAnyStdlib: true,
+			},
+			want:         true,
+			wantDenyRule: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, denyRule, err := checkImport(cmp, tt.args.resolvedImport, makeAllow(tt.args.dependOnAnyVendor, tt.args.dependOnAnyStdlib))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDenyRule, denyRule)
+		})
+	}
+}
+
+func TestChecker_checkProjectImportIsolated(t *testing.T) {
+	groupRef := common.NewReferenceSingleLine("/app/.go-arch-lint.yml", 12, 3)
+
+	cmp := arch.Component{
+		Name: common.NewReferable("module-orders", common.NewEmptyReference()),
+		IsolatedImports: []arch.IsolationRule{
+			{
+				Group:         common.NewReferable("modules", groupRef),
+				ComponentName: "module-users",
+				PublicAPI:     common.NewReferable("api", common.NewEmptyReference()),
+				ResolvedPaths: []models.ResolvedPath{makeTestResolvedPath("modules/users").Value},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		resolvedImport models.ResolvedImport
+		want           *models.IsolationRule
+	}{
+		{
+			name:           "isolated component package",
+			resolvedImport: makeTestResolvedProjectImport("modules/users"),
+			want: &models.IsolationRule{
+				Group:     "modules",
+				Component: "module-users",
+				PublicAPI: "api",
+				Reference: groupRef,
+			},
+		},
+		{
+			name:           "public api package",
+			resolvedImport: makeTestResolvedProjectImport("modules/users/api"),
+			want:           nil,
+		},
+		{
+			name:           "vendor with same name",
+			resolvedImport: makeTestResolvedVendorImport("modules/users"),
+			want:           nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkProjectImportIsolated(cmp, tt.resolvedImport))
+		})
+	}
+}
+
+func TestChecker_checkProjectImportExported(t *testing.T) {
+	componentRef := common.NewReferenceSingleLine("/app/.go-arch-lint.yml", 8, 5)
+
+	cmp := arch.Component{
+		Name: common.NewReferable("app", common.NewEmptyReference()),
+	}
+
+	notExported := map[string]common.Referable[string]{
+		testModulePath + "/billing/internal/calc": common.NewReferable("billing", componentRef),
+		testModulePath + "/app/internal/config":   common.NewReferable("app", componentRef),
+	}
+
+	tests := []struct {
+		name           string
+		resolvedImport models.ResolvedImport
+		want           *models.ExportRule
+	}{
+		{
+			name:           "not exported package of other component",
+			resolvedImport: makeTestResolvedProjectImport("billing/internal/calc"),
+			want: &models.ExportRule{
+				Component: "billing",
+				Reference: componentRef,
+			},
+		},
+		{
+			name:           "exported package",
+			resolvedImport: makeTestResolvedProjectImport("billing"),
+			want:           nil,
+		},
+		{
+			name:           "not exported package of same component",
+			resolvedImport: makeTestResolvedProjectImport("app/internal/config"),
+			want:           nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkProjectImportExported(cmp, tt.resolvedImport, notExported))
+		})
+	}
+}
+
+func TestChecker_checkLayers(t *testing.T) {
+	makeLayer := func(name string, components ...string) arch.Layer {
+		return arch.Layer{
+			Name:       common.NewReferable(name, common.NewReferenceSingleLine("/app/.go-arch-lint.yml", 42, 7)),
+			Components: components,
+		}
+	}
+
+	layers := []arch.Layer{
+		makeLayer("app", "app"),
+		makeLayer("infra", "infra-db", "infra-cache"),
+		makeLayer("domain", "domain"),
+	}
+
+	tests := []struct {
+		name      string
+		strict    bool
+		from      string
+		to        string
+		wantLayer string
+		wantBy    string
+		wantNext  string
+		wantNil   bool
+	}{
+		{
+			name:      "import of upper layer",
+			from:      "domain",
+			to:        "infra-db",
+			wantLayer: "infra",
+			wantBy:    "domain",
+		},
+		{
+			name:    "import of lower layer",
+			from:    "app",
+			to:      "domain",
+			wantNil: true,
+		},
+		{
+			name:      "strict import of not next layer",
+			strict:    true,
+			from:      "app",
+			to:        "domain",
+			wantLayer: "domain",
+			wantBy:    "app",
+			wantNext:  "infra",
+		},
+		{
+			name:    "same layer",
+			from:    "infra-db",
+			to:      "infra-cache",
+			wantNil: true,
+		},
+		{
+			name:    "component without layer",
+			from:    "domain",
+			to:      "shared",
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := arch.Spec{
+				Layers: arch.LayerPolicy{
+					Strict: makeBool(tt.strict),
+					Layers: layers,
+				},
+			}
+
+			checker := &Imports{spec: spec}
+			checker.componentLayers = checker.assembleComponentLayersMap(spec)
+
+			got := checker.checkLayers(arch.Component{Name: common.NewReferable(tt.from, common.NewEmptyReference())}, tt.to)
+			if tt.wantNil {
+				assert.Nil(t, got)
+				return
+			}
+
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.wantLayer, got.Layer)
+				assert.Equal(t, tt.wantBy, got.ImportedBy)
+				assert.Equal(t, tt.wantNext, got.Next)
+			}
+		})
+	}
+}
+
+func TestChecker_checkStdlibImport(t *testing.T) {
+	cmp := arch.Component{
+		Name: common.NewReferable("component", common.NewEmptyReference()),
+		AllowedStdlibGlobs: []common.Referable[[]models.Glob]{
+			common.NewReferable([]models.Glob{"net/**", "!net/rpc/**"}, common.NewEmptyReference()),
+			common.NewReferable([]models.Glob{"fmt"}, common.NewEmptyReference()),
+		},
+	}
+
+	type args struct {
+		resolvedImport    models.ResolvedImport
+		dependOnAnyStdlib bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "any stdlib allowed",
+			args: args{
+				resolvedImport:    makeTestResolvedStdlibImportNamed("os/exec"),
+				dependOnAnyStdlib: true,
+			},
+			want: true,
+		},
+		{
+			name: "allowed by exact name",
+			args: args{
+				resolvedImport: makeTestResolvedStdlibImportNamed("fmt"),
+			},
+			want: true,
+		},
+		{
+			name: "allowed by glob",
+			args: args{
+				resolvedImport: makeTestResolvedStdlibImportNamed("net/http/httptest"),
+			},
+			want: true,
+		},
+		{
+			name: "not allowed by exclusion",
+			args: args{
+				resolvedImport: makeTestResolvedStdlibImportNamed("net/rpc/jsonrpc"),
+			},
+			want: false,
+		},
+		{
+			name: "not allowed",
+			args: args{
+				resolvedImport: makeTestResolvedStdlibImportNamed("os/exec"),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, denyRule, err := checkImport(cmp, tt.args.resolvedImport, makeAllow(false, tt.args.dependOnAnyStdlib))
+			assert.NoError(t, err)
+			assert.Nil(t, denyRule)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/checker/deepscan"
+)
+
+// Leaks find leaky abstractions: exported API of component (funcs, methods,
+// fields, type aliases), that mention types of other components, which
+// consumers of this API may not depend on. Uses go/types info, so
+// checked only components with enabled deepScan. Checker is opt-in (allow.leaks = false)
+type Leaks struct {
+	projectFilesResolver projectFilesResolver
+	sourceCodeRenderer   sourceCodeRenderer
+
+	scanner           *deepscan.Searcher
+	spec              arch.Spec
+	result            results
+	packageComponents map[string]string
+}
+
+func NewLeaks(projectFilesResolver projectFilesResolver, sourceCodeRenderer sourceCodeRenderer) *Leaks {
+	return &Leaks{
+		projectFilesResolver: projectFilesResolver,
+		sourceCodeRenderer:   sourceCodeRenderer,
+		scanner:              deepscan.NewSearcher(),
+	}
+}
+
+func (c *Leaks) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	if spec.Allow.Leaks.Value {
+		return models.CheckResult{}, nil
+	}
+
+	c.spec = spec
+	c.result = newResults()
+
+	mapping, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed resolve project files: %w", err)
+	}
+
+	c.packageComponents = models.PackageComponents(mapping)
+
+	for _, component := range spec.Components {
+		if component.DeepScan.Value != true {
+			continue
+		}
+
+		err := c.checkComponent(component)
+		if err != nil {
+			return models.CheckResult{}, fmt.Errorf("component '%s' check failed: %w",
+				component.Name.Value,
+				err,
+			)
+		}
+	}
+
+	return c.result.assembleSortedResults(), nil
+}
+
+func (c *Leaks) checkComponent(cmp arch.Component) error {
+	scanned := make(map[string]struct{})
+
+	for _, packagePath := range cmp.ResolvedPaths {
+		absPath := packagePath.Value.AbsPath
+		if packagePath.Value.File {
+			absPath = filepath.Dir(absPath)
+		}
+
+		if _, ok := scanned[absPath]; ok {
+			continue
+		}
+
+		scanned[absPath] = struct{}{}
+
+		if c.packageComponents[absPath] != cmp.Name.Value {
+			// package excluded, or owned by other component
+			continue
+		}
+
+		err := c.checkPackage(cmp, absPath, packagePath.Value.ImportPath)
+		if err != nil {
+			return fmt.Errorf("failed scan '%s': %w", absPath, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Leaks) checkPackage(cmp arch.Component, absPackagePath string, importPath string) error {
+	consumers := c.consumers(cmp, importPath)
+	if len(consumers) == 0 {
+		// nobody can use package API
+		return nil
+	}
+
+	criteria, err := deepscan.NewCriteria(
+		deepscan.WithPackagePath(absPackagePath),
+	)
+	if err != nil {
+		return fmt.Errorf("failed prepare scan criteria: %w", err)
+	}
+
+	declarations, err := c.scanner.ExportedAPI(criteria)
+	if err != nil {
+		return fmt.Errorf("scan failed: %w", err)
+	}
+
+	for _, declaration := range declarations {
+		for _, namedType := range declaration.Types {
+			c.checkType(cmp, consumers, declaration, namedType)
+		}
+	}
+
+	return nil
+}
+
+func (c *Leaks) checkType(
+	cmp arch.Component,
+	consumers []arch.Component,
+	declaration deepscan.ExportedDeclaration,
+	namedType deepscan.NamedType,
+) {
+	leakedComponent, ok := c.typeComponent(namedType)
+	if !ok || leakedComponent == cmp.Name.Value {
+		return
+	}
+
+	deniedConsumers := make([]string, 0)
+	for _, consumer := range consumers {
+		if consumer.Name.Value == leakedComponent {
+			continue
+		}
+
+		if mayImportProject(consumer, namedType.Import) {
+			continue
+		}
+
+		deniedConsumers = append(deniedConsumers, consumer.Name.Value)
+	}
+
+	if len(deniedConsumers) == 0 {
+		return
+	}
+
+	reference := declaration.Definition.Place
+	c.result.addLeakWarning(models.CheckArchWarningLeak{
+		ComponentName:      cmp.Name.Value,
+		Declaration:        fmt.Sprintf("%s.%s", declaration.Definition.Pkg, declaration.Name),
+		DeclarationKind:    string(declaration.Kind),
+		LeakedType:         fmt.Sprintf("%s.%s", namedType.Pkg, namedType.Name),
+		LeakedComponent:    leakedComponent,
+		Consumers:          deniedConsumers,
+		FileRelativePath:   strings.TrimPrefix(reference.File, c.spec.RootDirectory.Value),
+		FileAbsolutePath:   reference.File,
+		ResolvedImportName: namedType.Import,
+		Reference:          reference,
+		SourceCodePreview:  c.sourceCodeRenderer.SourceCode(reference.ExtendRange(1, 1), false, true),
+	})
+}
+
+// consumers return all other components, that can import package
+func (c *Leaks) consumers(cmp arch.Component, importPath string) []arch.Component {
+	consumers := make([]arch.Component, 0)
+
+	for _, consumer := range c.spec.Components {
+		if consumer.Name.Value == cmp.Name.Value {
+			continue
+		}
+
+		if !mayImportProject(consumer, importPath) {
+			continue
+		}
+
+		consumers = append(consumers, consumer)
+	}
+
+	sort.Slice(consumers, func(i, j int) bool {
+		return consumers[i].Name.Value < consumers[j].Name.Value
+	})
+
+	return consumers
+}
+
+// typeComponent return owner component of type package,
+// types from stdlib and vendors are not owned by any component
+func (c *Leaks) typeComponent(namedType deepscan.NamedType) (string, bool) {
+	localPath, isProject := strings.CutPrefix(namedType.Import, c.spec.ModuleName.Value+"/")
+	if !isProject {
+		return "", false
+	}
+
+	component, ok := c.packageComponents[filepath.Join(c.spec.RootDirectory.Value, localPath)]
+	return component, ok
+}
+
+// mayImportProject is true, when component can import project package
+func mayImportProject(component arch.Component, importPath string) bool {
+	resolvedImport := models.ResolvedImport{
+		Name:       importPath,
+		ImportType: models.ImportTypeProject,
+	}
+
+	if checkProjectImportDenied(component, importPath) != nil {
+		return false
+	}
+
+	if checkProjectImportIsolated(component, resolvedImport) != nil {
+		return false
+	}
+
+	return checkProjectImport(component, resolvedImport)
+}
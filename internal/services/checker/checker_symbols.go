@@ -0,0 +1,276 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
+)
+
+const symbolsLoadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedCompiledGoFiles |
+	packages.NeedSyntax |
+	packages.NeedTypes |
+	packages.NeedTypesInfo
+
+type (
+	Symbols struct {
+		spec                 arch.Spec
+		projectFilesResolver projectFilesResolver
+		sourceCodeRenderer   sourceCodeRenderer
+		result               results
+		fileSet              *token.FileSet
+		files                map[string]symbolsFile
+	}
+
+	// symbolsFile is parsed go file with types info of its package
+	symbolsFile struct {
+		pkg *packages.Package
+		ast *ast.File
+	}
+
+	symbolUsage struct {
+		symbol     models.Symbol
+		importPath string
+		pos        token.Pos
+	}
+)
+
+func NewSymbols(
+	projectFilesResolver projectFilesResolver,
+	sourceCodeRenderer sourceCodeRenderer,
+) *Symbols {
+	return &Symbols{
+		projectFilesResolver: projectFilesResolver,
+		sourceCodeRenderer:   sourceCodeRenderer,
+	}
+}
+
+func (c *Symbols) Check(ctx context.Context, spec arch.Spec) (models.CheckResult, error) {
+	c.spec = spec
+	c.result = newResults()
+	c.fileSet = token.NewFileSet()
+	c.files = make(map[string]symbolsFile)
+
+	components := make(map[string]arch.Component)
+	for _, component := range spec.Components {
+		if len(component.Symbols.Forbidden) == 0 && len(component.Symbols.Allowed) == 0 {
+			continue
+		}
+
+		components[component.Name.Value] = component
+	}
+
+	if len(components) == 0 {
+		return c.result.assembleSortedResults(), nil
+	}
+
+	projectFiles, err := c.projectFilesResolver.ProjectFiles(ctx, spec)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	checkedFiles := make([]models.FileHold, 0, len(projectFiles))
+	directories := make(map[string]struct{})
+
+	for _, projectFile := range projectFiles {
+		if projectFile.ComponentID == nil {
+			continue
+		}
+
+		if _, ok := components[*projectFile.ComponentID]; !ok {
+			continue
+		}
+
+		checkedFiles = append(checkedFiles, projectFile)
+		directories[filepath.Dir(projectFile.File.Path)] = struct{}{}
+	}
+
+	err = c.loadFiles(directories)
+	if err != nil {
+		return models.CheckResult{}, fmt.Errorf("failed to load packages: %w", err)
+	}
+
+	for _, projectFile := range checkedFiles {
+		err := c.checkFile(components[*projectFile.ComponentID], projectFile.File)
+		if err != nil {
+			return models.CheckResult{}, fmt.Errorf("failed check file '%s': %w", projectFile.File.Path, err)
+		}
+	}
+
+	return c.result.assembleSortedResults(), nil
+}
+
+// checkFile find all usages of imported package identifiers (selectors like "time.Now"),
+// import scanner parse only imports, so file packages are loaded again with types info,
+// package selectors are resolved to import paths by type checker (package name
+// can be different from last segment of import path, like "k8s.io/api/core/v1")
+func (c *Symbols) checkFile(component arch.Component, file models.ProjectFile) error {
+	parsed, ok := c.files[file.Path]
+	if !ok {
+		// file is not part of package build (ignored by build constraints)
+		return nil
+	}
+
+	for _, usage := range fileSymbols(parsed.pkg, parsed.ast) {
+		rule, ruleReference, denied := checkSymbol(component, usage.symbol)
+		if !denied {
+			continue
+		}
+
+		reference := astUtil.PositionFromToken(c.fileSet.Position(usage.pos))
+		c.result.addSymbolWarning(models.CheckArchWarningSymbol{
+			ComponentName:      component.Name.Value,
+			Symbol:             usage.symbol,
+			Rule:               rule,
+			RuleReference:      ruleReference,
+			FileRelativePath:   strings.TrimPrefix(file.Path, c.spec.RootDirectory.Value),
+			FileAbsolutePath:   file.Path,
+			ResolvedImportName: usage.importPath,
+			Reference:          reference,
+			SourceCodePreview:  c.sourceCodeRenderer.SourceCode(reference.ExtendRange(1, 1), false, true),
+		})
+	}
+
+	return nil
+}
+
+// loadFiles load all packages from directories (with test packages) in one
+// pass, and index their go files by absolute path
+func (c *Symbols) loadFiles(directories map[string]struct{}) error {
+	if len(directories) == 0 {
+		return nil
+	}
+
+	patterns := make([]string, 0, len(directories))
+	for directory := range directories {
+		patterns = append(patterns, directory)
+	}
+
+	sort.Strings(patterns)
+
+	cfg := &packages.Config{
+		Mode:  symbolsLoadMode,
+		Fset:  c.fileSet,
+		Dir:   c.spec.RootDirectory.Value,
+		Tests: true,
+	}
+
+	loaded, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return fmt.Errorf("failed parse go source: %w", err)
+	}
+
+	c.files = packageFiles(loaded)
+	return nil
+}
+
+// packageFiles index go files of loaded packages by absolute path. With tests,
+// package is loaded twice (with and without _test.go files), so syntax of
+// test variant is preferred, it contains all package files
+func packageFiles(loaded []*packages.Package) map[string]symbolsFile {
+	files := make(map[string]symbolsFile)
+
+	for _, pkg := range loaded {
+		// test variant id: "example.com/app/domain [example.com/app/domain.test]"
+		testVariant := strings.HasSuffix(pkg.ID, ".test]")
+
+		for ind, compiledPath := range pkg.CompiledGoFiles {
+			if ind >= len(pkg.Syntax) {
+				break
+			}
+
+			if _, exist := files[compiledPath]; exist && !testVariant {
+				continue
+			}
+
+			files[compiledPath] = symbolsFile{
+				pkg: pkg,
+				ast: pkg.Syntax[ind],
+			}
+		}
+	}
+
+	return files
+}
+
+// fileSymbols return all usages of imported package identifiers in file.
+// Selectors on local variables, fields or shadowed package names are skipped
+func fileSymbols(pkg *packages.Package, fileAst *ast.File) []symbolUsage {
+	usages := make([]symbolUsage, 0)
+
+	ast.Inspect(fileAst, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		pkgIdent, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		pkgName, ok := pkg.TypesInfo.Uses[pkgIdent].(*types.PkgName)
+		if !ok {
+			return true
+		}
+
+		importPath := pkgName.Imported().Path()
+		usages = append(usages, symbolUsage{
+			symbol:     fmt.Sprintf("%s.%s", importPath, selector.Sel.Name),
+			importPath: importPath,
+			pos:        selector.Pos(),
+		})
+
+		return true
+	})
+
+	return usages
+}
+
+// checkSymbol will return true, when symbol usage is denied for component
+// by forbidden symbols, or by allowed symbols of same package
+func checkSymbol(component arch.Component, symbol models.Symbol) (models.SymbolRule, common.Reference, bool) {
+	for _, forbidden := range component.Symbols.Forbidden {
+		if forbidden.Value == symbol {
+			return models.SymbolRuleForbid, forbidden.Reference, true
+		}
+	}
+
+	restricted := false
+	reference := common.NewEmptyReference()
+	pkg := models.SymbolPackage(symbol)
+
+	for _, allowed := range component.Symbols.Allowed {
+		if models.SymbolPackage(allowed.Value) != pkg {
+			continue
+		}
+
+		if allowed.Value == symbol {
+			return "", common.NewEmptyReference(), false
+		}
+
+		if !restricted {
+			restricted = true
+			reference = allowed.Reference
+		}
+	}
+
+	if restricted {
+		return models.SymbolRuleAllow, reference, true
+	}
+
+	return "", common.NewEmptyReference(), false
+}
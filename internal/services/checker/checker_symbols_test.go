@@ -0,0 +1,112 @@
+package checker
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+func Test_fileSymbols(t *testing.T) {
+	const projectPath = "github.com/fe3dback/go-arch-lint/test/check/project_symbols"
+
+	loaded, err := packages.Load(&packages.Config{
+		Mode:  symbolsLoadMode,
+		Fset:  token.NewFileSet(),
+		Dir:   "../../../test/check/project_symbols",
+		Tests: true,
+	}, "./internal/app", "./internal/domain")
+	require.NoError(t, err)
+
+	files := packageFiles(loaded)
+
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{
+			file: "internal/app/app.go",
+			want: []string{
+				projectPath + "/internal/domain.NewUser",
+				"fmt.Println",
+				// package "v1" is imported from ".../clock/v1" path without alias
+				projectPath + "/internal/clock/v1.Now",
+			},
+		},
+		{
+			// test files of package
+			file: "internal/domain/user_test.go",
+			want: []string{"testing.T", "time.Now"},
+		},
+		{
+			// external test package
+			file: "internal/domain/example_test.go",
+			want: []string{"fmt.Println"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			filePath, err := filepath.Abs("../../../test/check/project_symbols/" + tt.file)
+			require.NoError(t, err)
+
+			parsed, ok := files[filePath]
+			require.True(t, ok)
+
+			symbols := make([]string, 0)
+			for _, usage := range fileSymbols(parsed.pkg, parsed.ast) {
+				symbols = append(symbols, usage.symbol)
+			}
+
+			assert.Equal(t, tt.want, symbols)
+		})
+	}
+}
+
+func Test_checkSymbol(t *testing.T) {
+	makeSymbols := func(symbols ...string) []common.Referable[models.Symbol] {
+		list := make([]common.Referable[models.Symbol], 0, len(symbols))
+		for _, symbol := range symbols {
+			list = append(list, common.NewReferable(symbol, common.NewEmptyReference()))
+		}
+
+		return list
+	}
+
+	component := arch.Component{
+		Symbols: arch.SymbolPolicy{
+			Forbidden: makeSymbols("time.Now", "fmt.Println"),
+			Allowed:   makeSymbols("strings.ToUpper", "github.com/pkg/errors.New"),
+		},
+	}
+
+	tests := []struct {
+		symbol     string
+		wantRule   models.SymbolRule
+		wantDenied bool
+	}{
+		{symbol: "time.Now", wantRule: models.SymbolRuleForbid, wantDenied: true},
+		{symbol: "time.Time", wantDenied: false},
+		{symbol: "fmt.Println", wantRule: models.SymbolRuleForbid, wantDenied: true},
+		{symbol: "fmt.Sprintf", wantDenied: false},
+		{symbol: "strings.ToUpper", wantDenied: false},
+		{symbol: "strings.ToLower", wantRule: models.SymbolRuleAllow, wantDenied: true},
+		{symbol: "github.com/pkg/errors.New", wantDenied: false},
+		{symbol: "github.com/pkg/errors.Wrap", wantRule: models.SymbolRuleAllow, wantDenied: true},
+		{symbol: "os.Exit", wantDenied: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			rule, _, denied := checkSymbol(component, tt.symbol)
+			assert.Equal(t, tt.wantDenied, denied)
+			assert.Equal(t, tt.wantRule, rule)
+		})
+	}
+}
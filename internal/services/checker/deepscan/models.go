@@ -4,27 +4,44 @@ import (
 	"github.com/fe3dback/go-arch-lint/internal/models/common"
 )
 
+const (
+	DeclarationKindFunc   DeclarationKind = "func"
+	DeclarationKindMethod DeclarationKind = "method"
+	DeclarationKindField  DeclarationKind = "field"
+	DeclarationKindAlias  DeclarationKind = "alias"
+)
+
+const (
+	GateKindInterface GateKind = "interface"
+	GateKindFunc      GateKind = "func"
+	GateKindTypeParam GateKind = "typeParam"
+)
+
 type (
+	DeclarationKind string
+	GateKind        string
+
 	InjectionMethod struct {
 		Name       string // method name (example: `NewProcessor`)
 		Definition Source // where method is defined
-		Gates      []Gate // method params with interface type
+		Gates      []Gate // method params, that accept injections (interface, func or type param)
 	}
 
 	Gate struct {
 		MethodName         string           // function name (func Hello(a,b int), name="Hello")
 		ParamName          string           // function param name (func (_a_,b int), name="a")
 		Index              int              // function param index (func (a,b bool, c int), for c index=2)
+		Kind               GateKind         // what can be injected: interface implementation, func value or type argument
 		MethodDefinition   Source           // where method is defined
 		ArgumentDefinition Source           // where method param type defined (func (a,b,c _int_))
-		Interface          Interface        // used interface for injection
+		Type               GateType         // param type used for injection (interface, func type or type param constraint)
 		Implementations    []Implementation // all code links to this param
 	}
 
-	Interface struct {
-		Name       string // interface name
-		Definition Source // where interface defined
-		GoType     string // interface go type
+	GateType struct {
+		Name       string // type name: interface, func type or type param constraint
+		Definition Source // where type defined (param itself, for anonymous types)
+		GoType     string // param go type
 	}
 
 	Implementation struct {
@@ -39,7 +56,7 @@ type (
 	}
 
 	Target struct {
-		StructName string // interface implementation type name
+		StructName string // interface implementation type name (or function name for func injections)
 		Definition Source // where this type defined
 	}
 
@@ -49,4 +66,17 @@ type (
 		Path   string           // package full abs path (example: "/home/user/go/src/myProject/internal/a")
 		Place  common.Reference // exactly place in source code
 	}
+
+	ExportedDeclaration struct {
+		Name       string          // declaration name (example: `NewService`, `Service.Find`)
+		Kind       DeclarationKind // func, method, field or alias
+		Definition Source          // where declaration is defined
+		Types      []NamedType     // named types, mentioned in declaration signature
+	}
+
+	NamedType struct {
+		Name   string // type name (example: `Row`)
+		Pkg    string // package name (example: "pg")
+		Import string // package full import path (example: "example.com/myProject/internal/pg")
+	}
 )
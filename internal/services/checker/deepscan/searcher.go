@@ -62,10 +62,11 @@ func NewSearcher() *Searcher {
 // so it`s good idea to check every package in project
 // with same Searcher instance
 //
-// This method will find all package functions with interfaces
-// and link it to all callers, with implementations
+// This method will find all package functions with injectable params
+// (interfaces, func values and type params) and link it to all callers,
+// with implementations (or passed functions for func params)
 // it will skip:
-//   - methods without interface (not injectable)
+//   - methods without interface, func or type param (not injectable)
 //   - private methods (nobody outside can call it)
 //   - only write chan (func (ch chan<-) (our code send something, so we not depend on implementations)
 //   - with placeholder param names (func (_ myInterface)), nobody can use _, so code not depend on interface
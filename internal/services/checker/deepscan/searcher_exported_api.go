@@ -0,0 +1,180 @@
+package deepscan
+
+import (
+	"fmt"
+	"go/types"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ExportedAPI find all exported package declarations with named
+// types, mentioned in their signatures:
+//   - functions and methods (params and results)
+//   - struct fields (including embedded)
+//   - type aliases
+//
+// Share same packages cache with Usages, so it`s cheap to
+// call both methods for same package
+func (s *Searcher) ExportedAPI(c Criteria) ([]ExportedDeclaration, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// current ctx
+	s.ctx.criteria = c
+
+	astPackage, err := cachedPackage(s.ctx, c.packagePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed get package at '%s': %w", c.packagePath, err)
+	}
+
+	if astPackage.Types == nil {
+		return nil, fmt.Errorf("package at '%s' not have types info", c.packagePath)
+	}
+
+	return s.extractExportedAPI(astPackage), nil
+}
+
+func (s *Searcher) extractExportedAPI(astPackage *packages.Package) []ExportedDeclaration {
+	list := make([]ExportedDeclaration, 0)
+	scope := astPackage.Types.Scope()
+
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if !obj.Exported() {
+			continue
+		}
+
+		switch typedObj := obj.(type) {
+		case *types.Func:
+			list = s.appendDeclaration(list, typedObj, typedObj.Name(), DeclarationKindFunc, typedObj.Type())
+		case *types.TypeName:
+			if typedObj.IsAlias() {
+				list = s.appendDeclaration(list, typedObj, typedObj.Name(), DeclarationKindAlias, typedObj.Type())
+				continue
+			}
+
+			list = append(list, s.extractTypeAPI(typedObj)...)
+		}
+	}
+
+	return list
+}
+
+// extractTypeAPI find exported fields and methods of named type
+func (s *Searcher) extractTypeAPI(typeName *types.TypeName) []ExportedDeclaration {
+	list := make([]ExportedDeclaration, 0)
+
+	named, ok := typeName.Type().(*types.Named)
+	if !ok {
+		return list
+	}
+
+	switch underlying := named.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < underlying.NumFields(); i++ {
+			field := underlying.Field(i)
+			if !field.Exported() {
+				continue
+			}
+
+			name := fmt.Sprintf("%s.%s", typeName.Name(), field.Name())
+			list = s.appendDeclaration(list, field, name, DeclarationKindField, field.Type())
+		}
+	case *types.Interface:
+		for i := 0; i < underlying.NumExplicitMethods(); i++ {
+			method := underlying.ExplicitMethod(i)
+			if !method.Exported() {
+				continue
+			}
+
+			name := fmt.Sprintf("%s.%s", typeName.Name(), method.Name())
+			list = s.appendDeclaration(list, method, name, DeclarationKindMethod, method.Type())
+		}
+	}
+
+	for i := 0; i < named.NumMethods(); i++ {
+		method := named.Method(i)
+		if !method.Exported() {
+			continue
+		}
+
+		name := fmt.Sprintf("%s.%s", typeName.Name(), method.Name())
+		list = s.appendDeclaration(list, method, name, DeclarationKindMethod, method.Type())
+	}
+
+	return list
+}
+
+func (s *Searcher) appendDeclaration(
+	list []ExportedDeclaration,
+	obj types.Object,
+	name string,
+	kind DeclarationKind,
+	objType types.Type,
+) []ExportedDeclaration {
+	mentioned := make([]NamedType, 0)
+	collectNamedTypes(objType, &mentioned, map[string]struct{}{})
+
+	if len(mentioned) == 0 {
+		return list
+	}
+
+	return append(list, ExportedDeclaration{
+		Name:       name,
+		Kind:       kind,
+		Definition: s.sourceFromToken(obj.Pos()),
+		Types:      mentioned,
+	})
+}
+
+// collectNamedTypes find all named types, mentioned in type
+// (function signatures, pointers, containers, generic arguments, etc...).
+// Underlying types of named types are not inspected, this is API of other package
+func collectNamedTypes(typ types.Type, mentioned *[]NamedType, unique map[string]struct{}) {
+	switch t := typ.(type) {
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil {
+			key := fmt.Sprintf("%s.%s", obj.Pkg().Path(), obj.Name())
+			if _, exist := unique[key]; !exist {
+				unique[key] = struct{}{}
+				*mentioned = append(*mentioned, NamedType{
+					Name:   obj.Name(),
+					Pkg:    obj.Pkg().Name(),
+					Import: obj.Pkg().Path(),
+				})
+			}
+		}
+
+		typeArgs := t.TypeArgs()
+		for i := 0; i < typeArgs.Len(); i++ {
+			collectNamedTypes(typeArgs.At(i), mentioned, unique)
+		}
+	case *types.Pointer:
+		collectNamedTypes(t.Elem(), mentioned, unique)
+	case *types.Slice:
+		collectNamedTypes(t.Elem(), mentioned, unique)
+	case *types.Array:
+		collectNamedTypes(t.Elem(), mentioned, unique)
+	case *types.Chan:
+		collectNamedTypes(t.Elem(), mentioned, unique)
+	case *types.Map:
+		collectNamedTypes(t.Key(), mentioned, unique)
+		collectNamedTypes(t.Elem(), mentioned, unique)
+	case *types.Signature:
+		collectNamedTypes(t.Params(), mentioned, unique)
+		collectNamedTypes(t.Results(), mentioned, unique)
+	case *types.Tuple:
+		for i := 0; i < t.Len(); i++ {
+			collectNamedTypes(t.At(i).Type(), mentioned, unique)
+		}
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			collectNamedTypes(t.Field(i).Type(), mentioned, unique)
+		}
+	case *types.Interface:
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			collectNamedTypes(t.ExplicitMethod(i).Type(), mentioned, unique)
+		}
+	}
+}
@@ -131,10 +131,15 @@ func (s *Searcher) applyMethodImplementationsInPackages(method *InjectionMethod,
 				for gateIndex := range method.Gates {
 					gate := &method.Gates[gateIndex]
 					callMethod := callExpr.Fun
+
+					if gate.Index >= len(callExpr.Args) {
+						// variadic param without values
+						continue
+					}
+
 					callParam := callExpr.Args[gate.Index]
 
-					paramType := astPackage.TypesInfo.TypeOf(callParam)
-					targetName, targetPos, valid := s.extractTargetFromCallParam(paramType)
+					targetName, targetPos, valid := s.extractTarget(astPackage, gate, callParam)
 					if !valid {
 						// unknown injection type, possible generics or other not known
 						// go features on current moment
@@ -184,6 +189,67 @@ func (s *Searcher) extractCodeFromASTNode(node ast.Expr) string {
 	return "unknown"
 }
 
+// extractTarget find what is injected into gate: implementation
+// type (for interfaces and type params) or function (for func types)
+func (s *Searcher) extractTarget(astPackage *packages.Package, gate *Gate, callParam ast.Expr) (name string, pos token.Pos, valid bool) {
+	if gate.Kind == GateKindFunc {
+		return s.extractTargetFromFuncValue(astPackage, callParam)
+	}
+
+	return s.extractTargetFromCallParam(astPackage.TypesInfo.TypeOf(callParam))
+}
+
+// extractTargetFromFuncValue find function, passed as func value:
+//   - `repository.Save` - package function
+//   - `repo.Save` - method value (name will be `Memory.Save`)
+//
+// anonymous functions `func() {..}` are defined by injector itself, so
+// not have any other owner, and skipped from analyse
+func (s *Searcher) extractTargetFromFuncValue(astPackage *packages.Package, callParam ast.Expr) (name string, pos token.Pos, valid bool) {
+	for {
+		parenExpr, ok := callParam.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+
+		callParam = parenExpr.X
+	}
+
+	var ident *ast.Ident
+
+	switch expr := callParam.(type) {
+	case *ast.Ident:
+		ident = expr
+	case *ast.SelectorExpr:
+		ident = expr.Sel
+	default:
+		return "", pos, false
+	}
+
+	fn, ok := astPackage.TypesInfo.Uses[ident].(*types.Func)
+	if !ok {
+		// variable with func value, or not function at all
+		return "", pos, false
+	}
+
+	signature, ok := fn.Type().(*types.Signature)
+	if !ok || signature.Recv() == nil {
+		return fn.Name(), fn.Pos(), true
+	}
+
+	if types.IsInterface(signature.Recv().Type()) {
+		// method of interface, implementation is not known
+		return "", pos, false
+	}
+
+	receiverName, _, valid := s.extractTargetFromCallParam(signature.Recv().Type())
+	if !valid {
+		return "", pos, false
+	}
+
+	return fmt.Sprintf("%s.%s", receiverName, fn.Name()), fn.Pos(), true
+}
+
 func (s *Searcher) extractTargetFromCallParam(t types.Type) (name string, pos token.Pos, valid bool) {
 	switch goType := t.(type) {
 	case *types.Named:
@@ -213,10 +279,20 @@ func (s *Searcher) findFunctionCalls(
 			return true
 		}
 
+		// generic function with explicit type arguments
+		// example: `repository.New[*pg.Repo](..)`
+		callFun := callExpr.Fun
+		switch indexExpr := callFun.(type) {
+		case *ast.IndexExpr:
+			callFun = indexExpr.X
+		case *ast.IndexListExpr:
+			callFun = indexExpr.X
+		}
+
 		// check if this outside package function
 		// example: `fmt.Println(..)`
 		// Println is external function in fmt package
-		astFunc, ok := callExpr.Fun.(*ast.SelectorExpr)
+		astFunc, ok := callFun.(*ast.SelectorExpr)
 		if !ok {
 			// not imported function
 			return true
@@ -73,14 +73,14 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 				continue
 			}
 
-			interfaceName, pos, isInterface := s.extractInterfaceName(paramType)
-			if !isInterface {
+			typeName, pos, kind, isGate := s.extractGateType(paramType)
+			if !isGate {
 				continue
 			}
 
 			if !pos.IsValid() {
-				// invalid pos, its anonymous `interface{}`
-				// or some kind of this staff
+				// invalid pos, its anonymous type: `interface{}`,
+				// `func() error` or inline type param constraint
 				pos = field.Pos()
 			}
 
@@ -88,10 +88,11 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 				MethodName:         method.Name.Name,
 				ParamName:          fieldIdent.Name,
 				Index:              typeIndex,
+				Kind:               kind,
 				MethodDefinition:   s.sourceFromToken(method.Pos()),
 				ArgumentDefinition: s.sourceFromToken(field.Pos()),
-				Interface: Interface{
-					Name:       interfaceName,
+				Type: GateType{
+					Name:       typeName,
 					Definition: s.sourceFromToken(pos),
 					GoType:     paramType.String(),
 				},
@@ -102,37 +103,61 @@ func (s *Searcher) extractMethodGates(astPackage *packages.Package, method *ast.
 	return params
 }
 
-func (s *Searcher) extractInterfaceName(t types.Type) (name string, ref token.Pos, isInterface bool) {
+// extractGateType check that param type can be used for injection:
+//   - interface: `func(a myInterface)`
+//   - func type: `func(fn func(ctx context.Context) error)` or `func(fn myHandlerFunc)`
+//   - type param: `func[T myInterface](a T)`
+func (s *Searcher) extractGateType(t types.Type) (name string, ref token.Pos, kind GateKind, isGate bool) {
 	switch goType := t.(type) {
 	// anon interfaces: `func(a interface{})`
 	case *types.Interface:
-		return t.String(), ref, true
+		return t.String(), ref, GateKindInterface, true
+
+	// anon func: `func(fn func() error)`
+	case *types.Signature:
+		return t.String(), ref, GateKindFunc, true
+
+	// type param: `func[T myInterface](a T)`, constraint without
+	// methods (`T any`, `T comparable`, `T ~int`) is generic container
+	// for values, not an injection of some behavior
+	case *types.TypeParam:
+		constraint, ok := goType.Constraint().Underlying().(*types.Interface)
+		if !ok || constraint.NumMethods() == 0 {
+			return "", ref, kind, false
+		}
+
+		if named, ok := goType.Constraint().(*types.Named); ok {
+			return named.Obj().Name(), named.Obj().Pos(), GateKindTypeParam, true
+		}
+
+		return goType.Constraint().String(), ref, GateKindTypeParam, true
 
-	// named type or interface: `func(a myInterface)` or `func (a int)`
+	// named type, interface or func: `func(a myInterface)`, `func(fn myHandlerFunc)` or `func (a int)`
 	case *types.Named:
-		if _, _, isInterface := s.extractInterfaceName(goType.Underlying()); !isInterface {
-			return "", ref, false
+		_, _, underlyingKind, isGate := s.extractGateType(goType.Underlying())
+		if !isGate || underlyingKind == GateKindTypeParam {
+			return "", ref, kind, false
 		}
 
-		return goType.Obj().Name(), goType.Obj().Pos(), true
+		return goType.Obj().Name(), goType.Obj().Pos(), underlyingKind, true
 
 	// pointer to type: `func(a *int)`, possible can point to interface
 	// but is useless in real code, so always skip this params
 	case *types.Pointer:
-		return "", ref, false
+		return "", ref, kind, false
 
 	case *types.Map:
-		return s.extractInterfaceName(goType.Elem())
+		return s.extractGateType(goType.Elem())
 
 	// `func(a []int)` or `func(a []myInterface)`
 	// can be slice of interface, so need to check it
 	case *types.Slice:
-		return s.extractInterfaceName(goType.Elem())
+		return s.extractGateType(goType.Elem())
 
 	// `func(a [5]int)` or `func(a [5]myInterface)`
 	// can be array of interface, so need to check it
 	case *types.Array:
-		return s.extractInterfaceName(goType.Elem())
+		return s.extractGateType(goType.Elem())
 
 	// `func(a chan int)` or `func(a chan myInterface)`
 	// need to check operand, and where is interface
@@ -142,20 +167,20 @@ func (s *Searcher) extractInterfaceName(t types.Type) (name string, ref token.Po
 	// chan    :: r/w, possible can inject
 	// if chan cannot inject anything, we skip it from analyse
 	case *types.Chan:
-		deepName, deepPos, isInterface := s.extractInterfaceName(goType.Elem())
-		if !isInterface {
-			return "", ref, false
+		deepName, deepPos, deepKind, isGate := s.extractGateType(goType.Elem())
+		if !isGate {
+			return "", ref, kind, false
 		}
 
 		if goType.Dir() == types.SendOnly {
 			// nothing be injected into write only interface
-			return "", ref, false
+			return "", ref, kind, false
 		}
 
-		return deepName, deepPos, true
+		return deepName, deepPos, deepKind, true
 
 	// not interface
 	default:
-		return "", ref, false
+		return "", ref, kind, false
 	}
 }
@@ -0,0 +1,15 @@
+package operations
+
+type callback8 func() error
+
+func FuncAnon8(fn func() error) {
+	_ = fn()
+}
+
+func FuncNamed8(fn callback8) {
+	_ = fn()
+}
+
+func FuncSlice8(fns []func() error) {
+	_ = fns
+}
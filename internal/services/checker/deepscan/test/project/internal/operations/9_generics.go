@@ -0,0 +1,17 @@
+package operations
+
+type fetcher9 interface {
+	Fetch() string
+}
+
+func GenericInterface9[T fetcher9](x T) {
+	_ = x.Fetch()
+}
+
+func GenericAny9[T any](x T) {
+	_ = x
+}
+
+func GenericComparable9[T comparable](x, y T) bool {
+	return x == y
+}
@@ -45,3 +45,88 @@ func test1Expected() []deepscan2.InjectionMethod {
 		},
 	}
 }
+
+func TestExportedAPI(t *testing.T) {
+	// assemble
+	_, callerDir, _, _ := runtime.Caller(0)
+	projectDir := filepath.Join(filepath.Dir(callerDir), "project")
+
+	searcher := deepscan2.NewSearcher()
+	criteria, err := deepscan2.NewCriteria(
+		deepscan2.WithPackagePath(filepath.Join(projectDir, "internal", "operations")),
+	)
+	assert.NoError(t, err)
+
+	// act
+	actual, err := searcher.ExportedAPI(criteria)
+
+	// assert
+	assert.NoError(t, err)
+
+	declarations := make(map[string]deepscan2.ExportedDeclaration)
+	for _, declaration := range actual {
+		declarations[declaration.Name] = declaration
+	}
+
+	assert.NotContains(t, declarations, "privateFindInPublic2")
+
+	alias := declarations["PublicFetcherForDI"]
+	assert.Equal(t, deepscan2.DeclarationKindAlias, alias.Kind)
+	assert.Equal(t, []string{"myFetcher"}, namedTypes(alias.Types))
+
+	method := declarations["RepositoryPublic.Find2"]
+	assert.Equal(t, deepscan2.DeclarationKindMethod, method.Kind)
+	assert.Equal(t, []string{"context.Context"}, namedTypes(method.Types))
+
+	constructor := declarations["NewProcessorBasicDual1"]
+	assert.Equal(t, deepscan2.DeclarationKindFunc, constructor.Kind)
+	assert.Equal(t, []string{"myFetcher", "myFetcherNamed", "processor1"}, namedTypes(constructor.Types))
+}
+
+func namedTypes(types []deepscan2.NamedType) []string {
+	names := make([]string, 0, len(types))
+	for _, namedType := range types {
+		if namedType.Pkg == "operations" {
+			names = append(names, namedType.Name)
+			continue
+		}
+
+		names = append(names, namedType.Pkg+"."+namedType.Name)
+	}
+
+	return names
+}
+
+func TestUsagesGateKinds(t *testing.T) {
+	// assemble
+	_, callerDir, _, _ := runtime.Caller(0)
+	projectDir := filepath.Join(filepath.Dir(callerDir), "project")
+
+	searcher := deepscan2.NewSearcher()
+	criteria, err := deepscan2.NewCriteria(
+		deepscan2.WithPackagePath(filepath.Join(projectDir, "internal", "operations")),
+		deepscan2.WithAnalyseScope(filepath.Join(projectDir, "internal")),
+	)
+	assert.NoError(t, err)
+
+	// act
+	actual, err := searcher.Usages(criteria)
+
+	// assert
+	assert.NoError(t, err)
+
+	kinds := make(map[string]deepscan2.GateKind)
+	for _, method := range actual {
+		for _, gate := range method.Gates {
+			kinds[method.Name] = gate.Kind
+		}
+	}
+
+	assert.Equal(t, deepscan2.GateKindInterface, kinds["AnonMethodInterface7"])
+	assert.Equal(t, deepscan2.GateKindFunc, kinds["FuncAnon8"])
+	assert.Equal(t, deepscan2.GateKindFunc, kinds["FuncNamed8"])
+	assert.Equal(t, deepscan2.GateKindFunc, kinds["FuncSlice8"])
+	assert.Equal(t, deepscan2.GateKindTypeParam, kinds["GenericInterface9"])
+	assert.NotContains(t, kinds, "GenericAny9")
+	assert.NotContains(t, kinds, "GenericComparable9")
+}
@@ -0,0 +1,48 @@
+package checker
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+// assembleOverlaps return all packages, matched by several
+// components. Components matched by package, so all package
+// files has same overlap, and only one overlap returned per package
+func assembleOverlaps(spec arch.Spec, projectFiles []models.FileHold) []models.CheckComponentOverlap {
+	packages := make(map[string]models.CheckComponentOverlap)
+
+	for _, hold := range projectFiles {
+		if hold.Overlap == nil || hold.ComponentID == nil {
+			continue
+		}
+
+		packagePath := filepath.Dir(hold.File.Path)
+		if _, exist := packages[packagePath]; exist {
+			continue
+		}
+
+		packages[packagePath] = models.CheckComponentOverlap{
+			PackageRelativePath: strings.TrimPrefix(packagePath, spec.RootDirectory.Value),
+			PackageAbsolutePath: packagePath,
+			Candidates:          hold.Overlap.Candidates,
+			Winner:              *hold.ComponentID,
+			Rule:                hold.Overlap.Rule,
+			Ambiguous:           hold.Overlap.Rule != models.OverlapRulePriority,
+		}
+	}
+
+	overlaps := make([]models.CheckComponentOverlap, 0, len(packages))
+	for _, overlap := range packages {
+		overlaps = append(overlaps, overlap)
+	}
+
+	sort.Slice(overlaps, func(i, j int) bool {
+		return overlaps[i].PackageRelativePath < overlaps[j].PackageRelativePath
+	})
+
+	return overlaps
+}
@@ -14,6 +14,10 @@ func newResults() results {
 	return results{
 		DependencyWarnings: []models.CheckArchWarningDependency{},
 		MatchWarnings:      []models.CheckArchWarningMatch{},
+		CapabilityWarnings: []models.CheckArchWarningCapability{},
+		SymbolWarnings:     []models.CheckArchWarningSymbol{},
+		LeakWarnings:       []models.CheckArchWarningLeak{},
+		OwnershipWarnings:  []models.CheckArchWarningOwnership{},
 	}
 }
 
@@ -25,6 +29,18 @@ func (res *results) addDependencyWarning(warn models.CheckArchWarningDependency)
 	res.DependencyWarnings = append(res.DependencyWarnings, warn)
 }
 
+func (res *results) addCapabilityWarning(warn models.CheckArchWarningCapability) {
+	res.CapabilityWarnings = append(res.CapabilityWarnings, warn)
+}
+
+func (res *results) addSymbolWarning(warn models.CheckArchWarningSymbol) {
+	res.SymbolWarnings = append(res.SymbolWarnings, warn)
+}
+
+func (res *results) addLeakWarning(warn models.CheckArchWarningLeak) {
+	res.LeakWarnings = append(res.LeakWarnings, warn)
+}
+
 func (res *results) assembleSortedResults() models.CheckResult {
 	sort.Slice(res.DependencyWarnings, func(i, j int) bool {
 		return res.DependencyWarnings[i].FileRelativePath < res.DependencyWarnings[j].FileRelativePath
@@ -34,8 +50,40 @@ func (res *results) assembleSortedResults() models.CheckResult {
 		return res.MatchWarnings[i].FileRelativePath < res.MatchWarnings[j].FileRelativePath
 	})
 
+	sort.Slice(res.CapabilityWarnings, func(i, j int) bool {
+		if res.CapabilityWarnings[i].FileRelativePath == res.CapabilityWarnings[j].FileRelativePath {
+			return res.CapabilityWarnings[i].Reference.Line < res.CapabilityWarnings[j].Reference.Line
+		}
+
+		return res.CapabilityWarnings[i].FileRelativePath < res.CapabilityWarnings[j].FileRelativePath
+	})
+
+	sort.Slice(res.SymbolWarnings, func(i, j int) bool {
+		if res.SymbolWarnings[i].FileRelativePath == res.SymbolWarnings[j].FileRelativePath {
+			return res.SymbolWarnings[i].Reference.Line < res.SymbolWarnings[j].Reference.Line
+		}
+
+		return res.SymbolWarnings[i].FileRelativePath < res.SymbolWarnings[j].FileRelativePath
+	})
+
+	sort.Slice(res.LeakWarnings, func(i, j int) bool {
+		if res.LeakWarnings[i].FileRelativePath == res.LeakWarnings[j].FileRelativePath {
+			if res.LeakWarnings[i].Reference.Line == res.LeakWarnings[j].Reference.Line {
+				return res.LeakWarnings[i].LeakedType < res.LeakWarnings[j].LeakedType
+			}
+
+			return res.LeakWarnings[i].Reference.Line < res.LeakWarnings[j].Reference.Line
+		}
+
+		return res.LeakWarnings[i].FileRelativePath < res.LeakWarnings[j].FileRelativePath
+	})
+
 	return models.CheckResult{
 		DependencyWarnings: res.DependencyWarnings,
 		MatchWarnings:      res.MatchWarnings,
+		CapabilityWarnings: res.CapabilityWarnings,
+		SymbolWarnings:     res.SymbolWarnings,
+		LeakWarnings:       res.LeakWarnings,
+		OwnershipWarnings:  res.OwnershipWarnings,
 	}
 }
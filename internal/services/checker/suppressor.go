@@ -0,0 +1,150 @@
+package checker
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+)
+
+// suppressor filter out warnings, ignored by
+// "//go-arch-lint:ignore <reason>" directives in source code
+type suppressor struct {
+	suppressions []*models.CheckSuppression
+	files        map[string][]*models.CheckSuppression
+}
+
+func newSuppressor(spec arch.Spec, projectFiles []models.FileHold) *suppressor {
+	s := &suppressor{
+		suppressions: []*models.CheckSuppression{},
+		files:        map[string][]*models.CheckSuppression{},
+	}
+
+	for _, hold := range projectFiles {
+		for _, directive := range hold.File.Suppressions {
+			suppression := &models.CheckSuppression{
+				FileRelativePath: strings.TrimPrefix(hold.File.Path, spec.RootDirectory.Value),
+				FileAbsolutePath: hold.File.Path,
+				Imports:          directive.Imports,
+				Reason:           directive.Reason,
+				Valid:            directive.Reason != "",
+				Reference:        directive.Reference,
+			}
+
+			s.suppressions = append(s.suppressions, suppression)
+
+			if suppression.Valid {
+				s.files[hold.File.Path] = append(s.files[hold.File.Path], suppression)
+			}
+		}
+	}
+
+	return s
+}
+
+func (s *suppressor) suppress(result models.CheckResult) models.CheckResult {
+	filtered := result
+	filtered.DependencyWarnings = []models.CheckArchWarningDependency{}
+	filtered.CapabilityWarnings = []models.CheckArchWarningCapability{}
+	filtered.SymbolWarnings = []models.CheckArchWarningSymbol{}
+	filtered.LeakWarnings = []models.CheckArchWarningLeak{}
+	filtered.DeepscanWarnings = []models.CheckArchWarningDeepscan{}
+	filtered.OwnershipWarnings = []models.CheckArchWarningOwnership{}
+
+	for _, warn := range result.DependencyWarnings {
+		if s.suppressed(warn.FileAbsolutePath, warn.ResolvedImportName) {
+			continue
+		}
+
+		filtered.DependencyWarnings = append(filtered.DependencyWarnings, warn)
+	}
+
+	for _, warn := range result.CapabilityWarnings {
+		if s.suppressed(warn.FileAbsolutePath, warn.ResolvedImportName) {
+			continue
+		}
+
+		filtered.CapabilityWarnings = append(filtered.CapabilityWarnings, warn)
+	}
+
+	for _, warn := range result.SymbolWarnings {
+		if s.suppressed(warn.FileAbsolutePath, warn.ResolvedImportName) {
+			continue
+		}
+
+		filtered.SymbolWarnings = append(filtered.SymbolWarnings, warn)
+	}
+
+	for _, warn := range result.LeakWarnings {
+		if s.suppressed(warn.FileAbsolutePath, warn.ResolvedImportName) {
+			continue
+		}
+
+		filtered.LeakWarnings = append(filtered.LeakWarnings, warn)
+	}
+
+	for _, warn := range result.DeepscanWarnings {
+		// injection is not import, so it suppressed by directives
+		// in file with injection code, by import of injected component package
+		if s.suppressed(warn.Dependency.Injection.File, warn.Dependency.ImportPath) {
+			continue
+		}
+
+		filtered.DeepscanWarnings = append(filtered.DeepscanWarnings, warn)
+	}
+
+	for _, warn := range result.OwnershipWarnings {
+		if s.suppressed(warn.Gate.Definition.File, warn.Interface.ImportPath) {
+			continue
+		}
+
+		filtered.OwnershipWarnings = append(filtered.OwnershipWarnings, warn)
+	}
+
+	return filtered
+}
+
+func (s *suppressor) suppressed(filePath string, importPath string) bool {
+	for _, suppression := range s.files[filePath] {
+		if !s.matchImport(suppression, importPath) {
+			continue
+		}
+
+		suppression.SuppressedCount++
+		return true
+	}
+
+	return false
+}
+
+func (s *suppressor) matchImport(suppression *models.CheckSuppression, importPath string) bool {
+	if len(suppression.Imports) == 0 {
+		return true
+	}
+
+	for _, suppressedImport := range suppression.Imports {
+		if suppressedImport == importPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *suppressor) assembleSuppressions() []models.CheckSuppression {
+	list := make([]models.CheckSuppression, 0, len(s.suppressions))
+	for _, suppression := range s.suppressions {
+		list = append(list, *suppression)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].FileRelativePath == list[j].FileRelativePath {
+			return list[i].Reference.Line < list[j].Reference.Line
+		}
+
+		return list[i].FileRelativePath < list[j].FileRelativePath
+	})
+
+	return list
+}
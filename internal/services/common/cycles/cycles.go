@@ -0,0 +1,169 @@
+package cycles
+
+import "sort"
+
+// Find return one shortest cycle for every strongly connected
+// part of directed graph (where each node reachable from any other).
+// Each cycle starts and ends with same node (with min name in cycle), example:
+//
+//	a -> b, b -> c, c -> a, c -> d
+//	result: [[a, b, c, a]]
+//
+// Self loops (a -> a) are not cycles.
+func Find(graph map[string][]string) [][]string {
+	result := make([][]string, 0)
+
+	for _, component := range stronglyConnected(graph) {
+		if len(component) < 2 {
+			continue
+		}
+
+		result = append(result, shortestCycle(graph, component))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i][0] < result[j][0]
+	})
+
+	return result
+}
+
+// stronglyConnected is Tarjan's algorithm
+func stronglyConnected(graph map[string][]string) [][]string {
+	nodes := sortedNodes(graph)
+
+	index := 0
+	indexes := make(map[string]int, len(nodes))
+	lowLinks := make(map[string]int, len(nodes))
+	onStack := make(map[string]bool, len(nodes))
+	stack := make([]string, 0, len(nodes))
+	components := make([][]string, 0)
+
+	var connect func(node string)
+	connect = func(node string) {
+		indexes[node] = index
+		lowLinks[node] = index
+		index++
+
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range graph[node] {
+			if _, visited := indexes[next]; !visited {
+				connect(next)
+				lowLinks[node] = minInt(lowLinks[node], lowLinks[next])
+				continue
+			}
+
+			if onStack[next] {
+				lowLinks[node] = minInt(lowLinks[node], indexes[next])
+			}
+		}
+
+		if lowLinks[node] != indexes[node] {
+			return
+		}
+
+		component := make([]string, 0)
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+
+			if last == node {
+				break
+			}
+		}
+
+		sort.Strings(component)
+		components = append(components, component)
+	}
+
+	for _, node := range nodes {
+		if _, visited := indexes[node]; !visited {
+			connect(node)
+		}
+	}
+
+	return components
+}
+
+// shortestCycle find shortest path from first (min) node of
+// strongly connected component back to itself (BFS)
+func shortestCycle(graph map[string][]string, component []string) []string {
+	inComponent := make(map[string]bool, len(component))
+	for _, node := range component {
+		inComponent[node] = true
+	}
+
+	start := component[0]
+	parents := map[string]string{}
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, next := range sortedEdges(graph[node]) {
+			if !inComponent[next] || (node == start && next == start) {
+				// self loop of start node is not cycle
+				continue
+			}
+
+			if next == start {
+				return buildCycle(parents, start, node)
+			}
+
+			if _, visited := parents[next]; visited {
+				continue
+			}
+
+			parents[next] = node
+			queue = append(queue, next)
+		}
+	}
+
+	// not reachable for strongly connected component
+	return []string{start, start}
+}
+
+func buildCycle(parents map[string]string, start, last string) []string {
+	path := []string{start}
+	for node := last; node != start; node = parents[node] {
+		path = append(path, node)
+	}
+
+	// reverse, except first node
+	for i, j := 1, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return append(path, start)
+}
+
+func sortedNodes(graph map[string][]string) []string {
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+
+	sort.Strings(nodes)
+	return nodes
+}
+
+func sortedEdges(edges []string) []string {
+	sorted := make([]string, len(edges))
+	copy(sorted, edges)
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,57 @@
+package cycles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		want  [][]string
+	}{
+		{
+			name:  "no cycles",
+			graph: map[string][]string{"a": {"b", "c"}, "b": {"c"}},
+			want:  [][]string{},
+		},
+		{
+			name:  "self loop is not cycle",
+			graph: map[string][]string{"a": {"a", "b"}, "b": {"b"}},
+			want:  [][]string{},
+		},
+		{
+			name:  "self loop in strongly connected part",
+			graph: map[string][]string{"a": {"a", "b"}, "b": {"a", "b"}},
+			want:  [][]string{{"a", "b", "a"}},
+		},
+		{
+			name:  "two components",
+			graph: map[string][]string{"b": {"a"}, "a": {"b"}},
+			want:  [][]string{{"a", "b", "a"}},
+		},
+		{
+			name:  "long cycle",
+			graph: map[string][]string{"c": {"a", "d"}, "a": {"b"}, "b": {"c"}},
+			want:  [][]string{{"a", "b", "c", "a"}},
+		},
+		{
+			name:  "shortest cycle in strongly connected part",
+			graph: map[string][]string{"a": {"b", "d"}, "b": {"c"}, "c": {"a"}, "d": {"a"}},
+			want:  [][]string{{"a", "d", "a"}},
+		},
+		{
+			name:  "separated cycles",
+			graph: map[string][]string{"a": {"b"}, "b": {"a", "c"}, "c": {"d"}, "d": {"c"}},
+			want:  [][]string{{"a", "b", "a"}, {"c", "d", "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Find(tt.graph))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+// how many unchanged lines will be displayed around changes
+const diffContextLines = 2
+
+type Differ struct{}
+
+func NewDiffer() *Differ {
+	return &Differ{}
+}
+
+// Diff build line-based diff between two texts (LCS), only changed lines
+// with some context around are returned, all other lines is skipped
+func (d *Differ) Diff(before, after []byte) []models.DiffLine {
+	a := strings.Split(string(before), "\n")
+	b := strings.Split(string(after), "\n")
+
+	// lcs[i][j] = longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+				continue
+			}
+
+			lcs[i][j] = lcs[i+1][j]
+			if lcs[i][j+1] > lcs[i][j] {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	full := make([]models.DiffLine, 0, len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			full = append(full, models.DiffLine{Kind: models.DiffLineKindContext, Line: j + 1, Text: b[j]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			full = append(full, models.DiffLine{Kind: models.DiffLineKindRemoved, Line: i + 1, Text: a[i]})
+			i++
+		default:
+			full = append(full, models.DiffLine{Kind: models.DiffLineKindAdded, Line: j + 1, Text: b[j]})
+			j++
+		}
+	}
+
+	return compactDiff(full)
+}
+
+func compactDiff(full []models.DiffLine) []models.DiffLine {
+	visible := make([]bool, len(full))
+	for ind, line := range full {
+		if line.Kind == models.DiffLineKindContext {
+			continue
+		}
+
+		for near := ind - diffContextLines; near <= ind+diffContextLines; near++ {
+			if near >= 0 && near < len(full) {
+				visible[near] = true
+			}
+		}
+	}
+
+	result := make([]models.DiffLine, 0)
+	skipped := 0
+
+	for ind, line := range full {
+		if !visible[ind] {
+			skipped++
+			continue
+		}
+
+		if skipped > 0 {
+			result = append(result, skippedLines(skipped))
+			skipped = 0
+		}
+
+		result = append(result, line)
+	}
+
+	if skipped > 0 && len(result) > 0 {
+		result = append(result, skippedLines(skipped))
+	}
+
+	return result
+}
+
+func skippedLines(count int) models.DiffLine {
+	return models.DiffLine{
+		Kind: models.DiffLineKindSkipped,
+		Text: fmt.Sprintf("%d lines unchanged", count),
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 )
 
+const goFileExt = ".go"
+
 type (
 	Resolver struct {
 	}
@@ -25,6 +27,10 @@ func (r Resolver) Resolve(absPath string) (resolvePaths []string, err error) {
 		return nil, fmt.Errorf("can`t match path mask '%s': %w", absPath, err)
 	}
 
+	// mask of go files (internal/user/*_grpc.go) resolved
+	// to files, all other masks resolved to directories
+	matchFiles := strings.HasSuffix(absPath, goFileExt)
+
 	dirs := make([]string, 0)
 	for _, match := range matches {
 		fileInfo, err := os.Stat(match)
@@ -33,7 +39,9 @@ func (r Resolver) Resolve(absPath string) (resolvePaths []string, err error) {
 		}
 
 		switch mode := fileInfo.Mode(); {
-		case mode.IsDir():
+		case mode.IsDir() && !matchFiles:
+			dirs = append(dirs, match)
+		case mode.IsRegular() && matchFiles:
 			dirs = append(dirs, match)
 		default:
 			continue
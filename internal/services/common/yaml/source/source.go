@@ -0,0 +1,217 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-yaml/ast"
+	"github.com/fe3dback/go-yaml/parser"
+	"github.com/fe3dback/go-yaml/token"
+)
+
+// Source is yaml document code, splitted to lines.
+// All changes applied directly to source code lines,
+// so comments and formatting outside of changed
+// tokens always preserved.
+//
+// Source is not re-parsed after changes, so nodes
+// positions is valid only until first change
+// (or changes should be applied from bottom to top)
+type Source struct {
+	lines []string
+	root  []*ast.MappingValueNode
+}
+
+func Parse(sourceCode []byte) (*Source, error) {
+	file, err := parser.ParseBytes(sourceCode, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed parse yaml: %w", err)
+	}
+
+	if len(file.Docs) == 0 {
+		return nil, fmt.Errorf("yaml document is empty")
+	}
+
+	src := &Source{
+		lines: strings.Split(string(sourceCode), "\n"),
+	}
+
+	switch body := file.Docs[0].Body.(type) {
+	case *ast.MappingNode:
+		src.root = body.Values
+	case *ast.MappingValueNode:
+		src.root = []*ast.MappingValueNode{body}
+	default:
+		return nil, fmt.Errorf("yaml document root should be map, got %T", file.Docs[0].Body)
+	}
+
+	return src, nil
+}
+
+// Root return all top level document nodes
+func (s *Source) Root() []*ast.MappingValueNode {
+	return s.root
+}
+
+// RootValue find top level document node by key
+func (s *Source) RootValue(key string) (*ast.MappingValueNode, bool) {
+	for _, node := range s.root {
+		if node.Key.GetToken().Value == key {
+			return node, true
+		}
+	}
+
+	return nil, false
+}
+
+// Line return source code line by number (1-based)
+func (s *Source) Line(line int) string {
+	if line < 1 || line > len(s.lines) {
+		return ""
+	}
+
+	return s.lines[line-1]
+}
+
+// LinesCount return count of source code lines
+func (s *Source) LinesCount() int {
+	return len(s.lines)
+}
+
+// Replace replace token value in source code by new value
+func (s *Source) Replace(tkn *token.Token, value string) error {
+	line, from, err := s.tokenLocation(tkn)
+	if err != nil {
+		return err
+	}
+
+	s.lines[line] = s.lines[line][:from] + value + s.lines[line][from+len(tkn.Value):]
+	return nil
+}
+
+// ReplaceRaw replace raw token source code (including quotes) by new value
+func (s *Source) ReplaceRaw(tkn *token.Token, value string) error {
+	line, from, err := s.tokenLocation(tkn)
+	if err != nil {
+		return err
+	}
+
+	to, err := s.rawEnd(tkn)
+	if err != nil {
+		return err
+	}
+
+	s.lines[line] = s.lines[line][:from] + value + s.lines[line][to:]
+	return nil
+}
+
+// Raw return raw token source code (including quotes)
+func (s *Source) Raw(tkn *token.Token) (string, error) {
+	line, from, err := s.tokenLocation(tkn)
+	if err != nil {
+		return "", err
+	}
+
+	to, err := s.rawEnd(tkn)
+	if err != nil {
+		return "", err
+	}
+
+	return s.lines[line][from:to], nil
+}
+
+// InsertAfter insert text into source code right after token
+func (s *Source) InsertAfter(tkn *token.Token, text string) error {
+	line, from, err := s.tokenLocation(tkn)
+	if err != nil {
+		return err
+	}
+
+	to := from + len(tkn.Value)
+	s.lines[line] = s.lines[line][:to] + text + s.lines[line][to:]
+	return nil
+}
+
+// InsertLines insert new lines into source code after line (1-based)
+func (s *Source) InsertLines(afterLine int, lines ...string) {
+	s.ReplaceLines(afterLine+1, afterLine, lines...)
+}
+
+// ReplaceLines replace lines range [from, to] (1-based, inclusive) by new lines.
+// When "to" is less than "from", lines only inserted before "from"
+func (s *Source) ReplaceLines(from, to int, lines ...string) {
+	if to < from-1 {
+		to = from - 1
+	}
+
+	result := make([]string, 0, len(s.lines)+len(lines))
+	result = append(result, s.lines[:from-1]...)
+	result = append(result, lines...)
+	result = append(result, s.lines[to:]...)
+
+	s.lines = result
+}
+
+func (s *Source) Code() []byte {
+	return []byte(strings.Join(s.lines, "\n"))
+}
+
+func (s *Source) tokenLocation(tkn *token.Token) (line int, column int, err error) {
+	line = tkn.Position.Line - 1
+	column = tkn.Position.Column - 1
+
+	if line < 0 || line >= len(s.lines) || column < 0 || column > len(s.lines[line]) {
+		return 0, 0, fmt.Errorf("token '%s' position %s out of source code", tkn.Value, tkn.Position.String())
+	}
+
+	if tkn.Type == token.SingleQuoteType || tkn.Type == token.DoubleQuoteType {
+		return line, column, nil
+	}
+
+	if !strings.HasPrefix(s.lines[line][column:], tkn.Value) {
+		return 0, 0, fmt.Errorf("token '%s' not found in source code at %s", tkn.Value, tkn.Position.String())
+	}
+
+	return line, column, nil
+}
+
+// rawEnd find column (0-based, exclusive) where raw token code ends
+func (s *Source) rawEnd(tkn *token.Token) (int, error) {
+	line, from, err := s.tokenLocation(tkn)
+	if err != nil {
+		return 0, err
+	}
+
+	code := s.lines[line]
+
+	switch tkn.Type {
+	case token.SingleQuoteType:
+		for ind := from + 1; ind < len(code); ind++ {
+			if code[ind] != '\'' {
+				continue
+			}
+
+			if ind+1 < len(code) && code[ind+1] == '\'' {
+				ind++ // escaped quote
+				continue
+			}
+
+			return ind + 1, nil
+		}
+	case token.DoubleQuoteType:
+		for ind := from + 1; ind < len(code); ind++ {
+			if code[ind] == '\\' {
+				ind++ // escaped char
+				continue
+			}
+
+			if code[ind] == '"' {
+				return ind + 1, nil
+			}
+		}
+	default:
+		return from + len(tkn.Value), nil
+	}
+
+	return 0, fmt.Errorf("multiline quoted token '%s' at %s not supported", tkn.Value, tkn.Position.String())
+}
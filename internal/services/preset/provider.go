@@ -0,0 +1,49 @@
+package preset
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//go:embed clean.yml
+var clean []byte
+
+//go:embed hexagonal.yml
+var hexagonal []byte
+
+//go:embed layered.yml
+var layered []byte
+
+var presets = map[string][]byte{
+	"clean":     clean,
+	"hexagonal": hexagonal,
+	"layered":   layered,
+}
+
+type Provider struct {
+}
+
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// Provide return source code of embedded preset archfile
+func (p *Provider) Provide(name string) ([]byte, error) {
+	if sourceCode, ok := presets[name]; ok {
+		return sourceCode, nil
+	}
+
+	return nil, fmt.Errorf("unknown preset '%s', available: [%s]", name, strings.Join(p.Names(), ", "))
+}
+
+func (p *Provider) Names() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
@@ -2,6 +2,7 @@ package holder
 
 import (
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
@@ -14,6 +15,8 @@ type (
 
 	matchedComponent struct {
 		id         string
+		priority   int
+		byFile     bool // matched by file glob, not by package directory
 		filesCount int
 	}
 )
@@ -35,6 +38,15 @@ func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.
 	// /a/src.go		= ["/", "/a"]
 	// /a/b/src.go		= ["/", "/a", "/b"]
 
+	matchedByFile := make(map[string]map[string]bool)
+	// example:
+	// /a/src_grpc.go	= {"/a/*_grpc.go": true}
+
+	priorities := make(map[string]int, len(components))
+	for _, component := range components {
+		priorities[component.Name.Value] = component.Priority.Value
+	}
+
 	backMapping := make(map[string]models.ProjectFile)
 	for _, file := range files {
 		backMapping[file.Path] = file
@@ -43,14 +55,22 @@ func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.
 			mapping[file.Path] = make([]string, 0)
 		}
 
-		components := componentsMatchesFile(file.Path, components)
-		for _, component := range components {
-			if _, ok := matchedCount[component]; !ok {
-				matchedCount[component] = 0
+		matches := componentsMatchesFile(file.Path, components)
+		for _, match := range matches {
+			if _, ok := matchedCount[match.id]; !ok {
+				matchedCount[match.id] = 0
 			}
 
-			matchedCount[component]++
-			mapping[file.Path] = append(mapping[file.Path], component)
+			matchedCount[match.id]++
+			mapping[file.Path] = append(mapping[file.Path], match.id)
+
+			if match.byFile {
+				if _, ok := matchedByFile[file.Path]; !ok {
+					matchedByFile[file.Path] = make(map[string]bool)
+				}
+
+				matchedByFile[file.Path][match.id] = true
+			}
 		}
 	}
 
@@ -65,76 +85,140 @@ func (h *Holder) HoldProjectFiles(files []models.ProjectFile, components []arch.
 			continue
 		}
 
-		defComponent := componentIDs[0]
-		holder := matchedComponent{
-			id:         defComponent,
-			filesCount: matchedCount[defComponent],
+		candidates := make([]matchedComponent, 0, len(componentIDs))
+		for _, componentID := range componentIDs {
+			candidates = append(candidates, matchedComponent{
+				id:         componentID,
+				priority:   priorities[componentID],
+				byFile:     matchedByFile[filePath][componentID],
+				filesCount: matchedCount[componentID],
+			})
 		}
 
-		if len(componentIDs) > 1 {
-			for _, componentID := range componentIDs {
-				variant := matchedComponent{
-					id:         componentID,
-					filesCount: matchedCount[componentID],
-				}
-
-				if compare(holder, variant) {
-					holder = variant
-				}
-			}
-		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return compare(candidates[j], candidates[i])
+		})
 
+		holder := candidates[0]
 		results = append(results, models.FileHold{
-			File:        backMapping[filePath],
-			ComponentID: &holder.id,
+			File:          backMapping[filePath],
+			ComponentID:   &holder.id,
+			MatchedByFile: holder.byFile,
+			Overlap:       overlap(candidates),
 		})
 	}
 
 	return results
 }
 
+func overlap(candidates []matchedComponent) *models.ComponentOverlap {
+	if len(candidates) < 2 {
+		return nil
+	}
+
+	_, rule := compareWithRule(candidates[1], candidates[0])
+	if rule == models.OverlapRuleFileMatch {
+		// file glob is always more specific than package glob,
+		// so this is not overlap, but explicit package split
+		return nil
+	}
+
+	result := &models.ComponentOverlap{
+		Candidates: make([]models.OverlapCandidate, 0, len(candidates)),
+		Rule:       rule,
+	}
+
+	for _, candidate := range candidates {
+		result.Candidates = append(result.Candidates, models.OverlapCandidate{
+			Name:       candidate.id,
+			FilesCount: candidate.filesCount,
+		})
+	}
+
+	return result
+}
+
 // should return true if B better than A
 func compare(a, b matchedComponent) bool {
+	better, _ := compareWithRule(a, b)
+	return better
+}
+
+// same as compare, but also return rule, that choose better component
+func compareWithRule(a, b matchedComponent) (bool, models.OverlapRule) {
 	if a.id == b.id {
-		return false
+		return false, models.OverlapRuleNameOrder
+	}
+
+	// explicit priority from archfile
+	if b.priority != a.priority {
+		return b.priority > a.priority, models.OverlapRulePriority
+	}
+
+	// file glob is more specific than package directory
+	if b.byFile != a.byFile {
+		return b.byFile, models.OverlapRuleFileMatch
 	}
 
 	// smallest files match count
 	if b.filesCount != a.filesCount {
-		return b.filesCount < a.filesCount
+		return b.filesCount < a.filesCount, models.OverlapRuleFilesCount
 	}
 
 	// has more specified directory
 	aLen := strings.Count(a.id, "/")
 	bLen := strings.Count(b.id, "/")
 	if bLen != aLen {
-		return bLen > aLen
+		return bLen > aLen, models.OverlapRuleDepth
 	}
 
 	// longest name
 	if len(b.id) != len(a.id) {
-		return len(b.id) > len(a.id)
+		return len(b.id) > len(a.id), models.OverlapRuleNameLength
 	}
 
 	// stable sort for equal priority path's
-	return b.id < a.id
+	return b.id < a.id, models.OverlapRuleNameOrder
 }
 
-func componentsMatchesFile(filePath string, components []arch.Component) []string {
-	matched := make([]string, 0)
+func componentsMatchesFile(filePath string, components []arch.Component) []matchedComponent {
+	matched := make([]matchedComponent, 0)
 	packagePath := filepath.Dir(filePath)
 
 	for _, component := range components {
+		if componentMatchFile(filePath, component) {
+			matched = append(matched, matchedComponent{id: component.Name.Value, byFile: true})
+			continue
+		}
+
 		if componentMatchPackage(packagePath, component) {
-			matched = append(matched, component.Name.Value)
+			matched = append(matched, matchedComponent{id: component.Name.Value})
 		}
 	}
 
 	return matched
 }
 
+func componentMatchFile(filePath string, component arch.Component) bool {
+	for _, componentPathRef := range component.ResolvedPaths {
+		if !componentPathRef.Value.File {
+			continue
+		}
+
+		if componentPathRef.Value.AbsPath == filePath {
+			return true
+		}
+	}
+
+	return false
+}
+
 func componentMatchPackage(packagePath string, component arch.Component) bool {
 	for _, componentDirectoryRef := range component.ResolvedPaths {
+		if componentDirectoryRef.Value.File {
+			continue
+		}
+
 		resolvedPackagePath := componentDirectoryRef.Value.AbsPath
 		if packageMathPath(packagePath, resolvedPackagePath) {
 			return true
@@ -138,7 +138,7 @@ func Test_componentsMatchesFile(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []string
+		want []matchedComponent
 	}{
 		{
 			name: "s1",
@@ -183,7 +183,43 @@ func Test_componentsMatchesFile(t *testing.T) {
 					},
 				},
 			},
-			want: []string{"A", "B"},
+			want: []matchedComponent{{id: "A"}, {id: "B"}},
+		},
+		{
+			name: "file glob",
+			args: args{
+				filePath: "/app/file_grpc.go",
+				components: []arch.Component{
+					{
+						Name: common.NewReferable("domain", common.NewEmptyReference()),
+						ResolvedPaths: []common.Referable[models.ResolvedPath]{
+							common.NewReferable(
+								models.ResolvedPath{AbsPath: "/app"},
+								common.NewEmptyReference(),
+							),
+						},
+					},
+					{
+						Name: common.NewReferable("transport", common.NewEmptyReference()),
+						ResolvedPaths: []common.Referable[models.ResolvedPath]{
+							common.NewReferable(
+								models.ResolvedPath{AbsPath: "/app/file_grpc.go", File: true},
+								common.NewEmptyReference(),
+							),
+						},
+					},
+					{
+						Name: common.NewReferable("other", common.NewEmptyReference()),
+						ResolvedPaths: []common.Referable[models.ResolvedPath]{
+							common.NewReferable(
+								models.ResolvedPath{AbsPath: "/app/file.go", File: true},
+								common.NewEmptyReference(),
+							),
+						},
+					},
+				},
+			},
+			want: []matchedComponent{{id: "domain"}, {id: "transport", byFile: true}},
 		},
 	}
 	for _, tt := range tests {
@@ -286,3 +322,61 @@ func Test_compare(t *testing.T) {
 		})
 	}
 }
+
+func Test_compareWithRule(t *testing.T) {
+	tests := []struct {
+		name string
+		a    matchedComponent
+		b    matchedComponent
+		want models.OverlapRule
+	}{
+		{
+			name: "priority",
+			a:    matchedComponent{id: "a/b/c", filesCount: 3},
+			b:    matchedComponent{id: "a", priority: 1, filesCount: 10},
+			want: models.OverlapRulePriority,
+		},
+		{
+			name: "file match",
+			a:    matchedComponent{id: "a/b/c", filesCount: 3},
+			b:    matchedComponent{id: "a", byFile: true, filesCount: 10},
+			want: models.OverlapRuleFileMatch,
+		},
+		{
+			name: "files count",
+			a:    matchedComponent{id: "a", filesCount: 10},
+			b:    matchedComponent{id: "b", filesCount: 3},
+			want: models.OverlapRuleFilesCount,
+		},
+		{
+			name: "depth",
+			a:    matchedComponent{id: "a/b", filesCount: 3},
+			b:    matchedComponent{id: "a/b/c", filesCount: 3},
+			want: models.OverlapRuleDepth,
+		},
+		{
+			name: "name length",
+			a:    matchedComponent{id: "aaa", filesCount: 3},
+			b:    matchedComponent{id: "aaaa", filesCount: 3},
+			want: models.OverlapRuleNameLength,
+		},
+		{
+			name: "name order",
+			a:    matchedComponent{id: "bbb", filesCount: 3},
+			b:    matchedComponent{id: "aaa", filesCount: 3},
+			want: models.OverlapRuleNameOrder,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			better, rule := compareWithRule(tt.a, tt.b)
+			if !better {
+				t.Errorf("compareWithRule() b should be better than a")
+			}
+
+			if rule != tt.want {
+				t.Errorf("compareWithRule() rule = %v, want %v", rule, tt.want)
+			}
+		})
+	}
+}
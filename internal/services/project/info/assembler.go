@@ -31,6 +31,19 @@ func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (comm
 		return common.Project{}, fmt.Errorf("not found archfile in '%s'", goArchFilePath)
 	}
 
+	return a.ModuleInfo(rootDirectory, archFilePath)
+}
+
+// ModuleInfo is same as ProjectInfo, but not require archfile existence
+// (archfile path is only resolved). Used when archfile not created yet.
+func (a *Assembler) ModuleInfo(rootDirectory string, archFilePath string) (common.Project, error) {
+	projectPath, err := filepath.Abs(rootDirectory)
+	if err != nil {
+		return common.Project{}, fmt.Errorf("failed to resolve abs path '%s'", rootDirectory)
+	}
+
+	goArchFilePath := filepath.Clean(fmt.Sprintf("%s/%s", projectPath, archFilePath))
+
 	// check go.mod
 	goModFilePath := filepath.Clean(fmt.Sprintf("%s/%s", projectPath, models.DefaultGoModFileName))
 	_, err = os.Stat(goModFilePath)
@@ -55,6 +68,21 @@ func (a *Assembler) ProjectInfo(rootDirectory string, archFilePath string) (comm
 	}, nil
 }
 
+// ModuleRequirements return all module paths from go.mod 'require' section
+func (a *Assembler) ModuleRequirements(goModFilePath string) ([]string, error) {
+	goModFile, err := checkCmdParseGoModFile(goModFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("can`t parse gomod: %w", err)
+	}
+
+	requirements := make([]string, 0, len(goModFile.Require))
+	for _, require := range goModFile.Require {
+		requirements = append(requirements, require.Mod.Path)
+	}
+
+	return requirements, nil
+}
+
 func checkCmdExtractModuleName(goModPath string) (string, error) {
 	goModFile, err := checkCmdParseGoModFile(goModPath)
 	if err != nil {
@@ -109,14 +109,15 @@ func (r *Scanner) inScope(ctx *resolveContext, path string) bool {
 }
 
 func (r *Scanner) parse(ctx *resolveContext, path string) error {
-	fileAst, err := parser.ParseFile(ctx.tokenSet, path, nil, parser.ImportsOnly)
+	fileAst, err := parser.ParseFile(ctx.tokenSet, path, nil, parser.ImportsOnly|parser.ParseComments)
 	if err != nil {
 		return fmt.Errorf("failed to parse go source code at '%s': %w", path, err)
 	}
 
 	ctx.results = append(ctx.results, models.ProjectFile{
-		Path:    path,
-		Imports: r.extractImports(ctx, fileAst),
+		Path:         path,
+		Imports:      r.extractImports(ctx, fileAst),
+		Suppressions: r.extractSuppressions(ctx, fileAst),
 	})
 
 	return nil
@@ -0,0 +1,108 @@
+package scanner
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	astUtil "github.com/fe3dback/go-arch-lint/internal/services/common/ast"
+)
+
+const suppressionDirective = "//go-arch-lint:ignore"
+
+// extractSuppressions find all ignore directives in file:
+// - before "package" clause, directive suppress all file warnings
+// - in import doc/line comments, directive suppress only this import
+// - above "import (" block, directive suppress all imports from block
+func (r *Scanner) extractSuppressions(ctx *resolveContext, fileAst *ast.File) []models.Suppression {
+	directives := make(map[token.Pos]*models.Suppression)
+
+	for _, group := range fileAst.Comments {
+		if group.End() >= fileAst.Package {
+			break
+		}
+
+		r.collectSuppressions(ctx, directives, group, nil)
+	}
+
+	for _, decl := range fileAst.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			importSpec, ok := spec.(*ast.ImportSpec)
+			if !ok {
+				continue
+			}
+
+			importPath := strings.Trim(importSpec.Path.Value, "\"")
+			r.collectSuppressions(ctx, directives, genDecl.Doc, &importPath)
+			r.collectSuppressions(ctx, directives, importSpec.Doc, &importPath)
+			r.collectSuppressions(ctx, directives, importSpec.Comment, &importPath)
+		}
+	}
+
+	suppressions := make([]models.Suppression, 0, len(directives))
+	for _, suppression := range directives {
+		suppressions = append(suppressions, *suppression)
+	}
+
+	sort.Slice(suppressions, func(i, j int) bool {
+		return suppressions[i].Reference.Line < suppressions[j].Reference.Line
+	})
+
+	return suppressions
+}
+
+func (r *Scanner) collectSuppressions(
+	ctx *resolveContext,
+	directives map[token.Pos]*models.Suppression,
+	group *ast.CommentGroup,
+	importPath *string,
+) {
+	if group == nil {
+		return
+	}
+
+	for _, comment := range group.List {
+		reason, ok := parseSuppression(comment.Text)
+		if !ok {
+			continue
+		}
+
+		suppression, exist := directives[comment.Pos()]
+		if !exist {
+			suppression = &models.Suppression{
+				Reason:    reason,
+				Imports:   []string{},
+				Reference: astUtil.PositionFromToken(ctx.tokenSet.Position(comment.Pos())),
+			}
+
+			directives[comment.Pos()] = suppression
+		}
+
+		if importPath != nil {
+			suppression.Imports = append(suppression.Imports, *importPath)
+		}
+	}
+}
+
+// parseSuppression return directive reason, when comment is
+// ignore directive. Reason can be empty, when not specified
+func parseSuppression(comment string) (reason string, ok bool) {
+	if !strings.HasPrefix(comment, suppressionDirective) {
+		return "", false
+	}
+
+	reason = strings.TrimPrefix(comment, suppressionDirective)
+	if reason != "" && reason[0] != ' ' && reason[0] != '\t' {
+		// another directive, like "//go-arch-lint:ignored"
+		return "", false
+	}
+
+	return strings.TrimSpace(reason), true
+}
@@ -0,0 +1,32 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseSuppression(t *testing.T) {
+	tests := []struct {
+		comment    string
+		wantReason string
+		wantOk     bool
+	}{
+		{comment: "//go-arch-lint:ignore legacy code", wantReason: "legacy code", wantOk: true},
+		{comment: "//go-arch-lint:ignore\tlegacy code  ", wantReason: "legacy code", wantOk: true},
+		{comment: "//go-arch-lint:ignore", wantReason: "", wantOk: true},
+		{comment: "//go-arch-lint:ignore   ", wantReason: "", wantOk: true},
+		{comment: "//go-arch-lint:ignored legacy code", wantReason: "", wantOk: false},
+		{comment: "// go-arch-lint:ignore legacy code", wantReason: "", wantOk: false},
+		{comment: "/* go-arch-lint:ignore legacy code */", wantReason: "", wantOk: false},
+		{comment: "//nolint:all", wantReason: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.comment, func(t *testing.T) {
+			gotReason, gotOk := parseSuppression(tt.comment)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.wantReason, gotReason)
+		})
+	}
+}
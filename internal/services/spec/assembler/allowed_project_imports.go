@@ -2,7 +2,6 @@ package assembler
 
 import (
 	"fmt"
-	"path"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
@@ -36,26 +35,20 @@ func (aia *allowedProjectImportsAssembler) assemble(
 	}
 
 	for _, name := range allowedComponents {
-		yamlComponent, ok := yamlDocument.Components()[name]
+		relativePaths, ok := componentRelativePaths(yamlDocument, name)
 		if !ok {
 			continue
 		}
 
-		for _, componentIn := range yamlComponent.Value.RelativePaths() {
-			relativeGlobPath := componentIn
-
-			resolved, err := aia.resolver.resolveLocalGlobPath(
-				path.Clean(fmt.Sprintf("%s/%s",
-					yamlDocument.WorkingDirectory().Value,
-					string(relativeGlobPath),
-				)),
-			)
-			if err != nil {
-				return nil, fmt.Errorf("failed to resolve component path '%s'", relativeGlobPath)
-			}
-
-			list = append(list, resolved...)
+		resolved, err := aia.resolver.resolveComponentPaths(
+			yamlDocument.WorkingDirectory().Value,
+			relativePaths,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve component '%s' paths: %w", name, err)
 		}
+
+		list = append(list, resolved...)
 	}
 
 	return list, nil
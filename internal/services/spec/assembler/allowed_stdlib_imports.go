@@ -0,0 +1,37 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type allowedStdlibImportsAssembler struct{}
+
+func newAllowedStdlibImportsAssembler() *allowedStdlibImportsAssembler {
+	return &allowedStdlibImportsAssembler{}
+}
+
+func (asa *allowedStdlibImportsAssembler) assemble(
+	yamlDocument spec.Document,
+	stdlibNames []string,
+) ([]common.Referable[[]models.Glob], error) {
+	list := make([]common.Referable[[]models.Glob], 0)
+
+	allowedStdlib := make([]string, 0)
+	allowedStdlib = append(allowedStdlib, stdlibNames...)
+	for _, stdlibName := range yamlDocument.CommonStdlib() {
+		allowedStdlib = append(allowedStdlib, stdlibName.Value)
+	}
+
+	for _, name := range allowedStdlib {
+		yamlStdlib, ok := yamlDocument.Stdlib()[name]
+		if !ok {
+			continue
+		}
+
+		list = append(list, common.NewReferable(yamlStdlib.Value.ImportPaths(), yamlStdlib.Reference))
+	}
+
+	return list, nil
+}
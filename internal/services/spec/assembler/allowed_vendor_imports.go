@@ -21,8 +21,8 @@ func newAllowedVendorImportsAssembler(
 func (aia *allowedVendorImportsAssembler) assemble(
 	yamlDocument spec.Document,
 	vendorNames []string,
-) ([]common.Referable[models.Glob], error) {
-	list := make([]common.Referable[models.Glob], 0)
+) ([]common.Referable[[]models.Glob], error) {
+	list := make([]common.Referable[[]models.Glob], 0)
 
 	allowedVendors := make([]string, 0)
 	allowedVendors = append(allowedVendors, vendorNames...)
@@ -36,9 +36,7 @@ func (aia *allowedVendorImportsAssembler) assemble(
 			continue
 		}
 
-		for _, vendorIn := range yamlVendor.Value.ImportPaths() {
-			list = append(list, common.NewReferable(vendorIn, yamlVendor.Reference))
-		}
+		list = append(list, common.NewReferable(yamlVendor.Value.ImportPaths(), yamlVendor.Reference))
 	}
 
 	return list, nil
@@ -71,11 +71,19 @@ func (sa *Assembler) Assemble(prj common.Project) (arch.Spec, error) {
 			newAllowedVendorImportsAssembler(
 				resolver,
 			),
+			newAllowedStdlibImportsAssembler(),
+			newDeniedImportsAssembler(
+				resolver,
+			),
 		),
+		newGroupsAssembler(),
+		newLayersAssembler(),
 		newExcludeAssembler(resolver),
 		newExcludeFilesMatcherAssembler(),
 		newAllowAssembler(),
 		newWorkdirAssembler(),
+		newOverridesAssembler(),
+		newCyclesAssembler(),
 	})
 
 	err = assembler.assemble(&spec, document)
@@ -16,6 +16,10 @@ func (efa *allowAssembler) assemble(spec *arch.Spec, document spec.Document) err
 	spec.Allow = arch.Allow{
 		DepOnAnyVendor: document.Options().IsDependOnAnyVendor(),
 		DeepScan:       document.Options().DeepScan(),
+		DepOnAnyStdlib: document.Options().IsDependOnAnyStdlib(),
+		OwnInterfaces:  document.Options().OwnInterfaces(),
+		Cycles:         document.Options().Cycles(),
+		Leaks:          document.Options().Leaks(),
 	}
 
 	return nil
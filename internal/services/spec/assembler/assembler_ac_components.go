@@ -2,7 +2,6 @@ package assembler
 
 import (
 	"fmt"
-	"path"
 
 	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
@@ -15,6 +14,8 @@ type (
 		resolver                       *resolver
 		allowedProjectImportsAssembler *allowedProjectImportsAssembler
 		allowedVendorImportsAssembler  *allowedVendorImportsAssembler
+		allowedStdlibImportsAssembler  *allowedStdlibImportsAssembler
+		deniedImportsAssembler         *deniedImportsAssembler
 	}
 )
 
@@ -22,49 +23,111 @@ func newComponentsAssembler(
 	resolver *resolver,
 	allowedProjectImportsAssembler *allowedProjectImportsAssembler,
 	allowedVendorImportsAssembler *allowedVendorImportsAssembler,
+	allowedStdlibImportsAssembler *allowedStdlibImportsAssembler,
+	deniedImportsAssembler *deniedImportsAssembler,
 ) *componentsAssembler {
 	return &componentsAssembler{
 		resolver:                       resolver,
 		allowedProjectImportsAssembler: allowedProjectImportsAssembler,
 		allowedVendorImportsAssembler:  allowedVendorImportsAssembler,
+		allowedStdlibImportsAssembler:  allowedStdlibImportsAssembler,
+		deniedImportsAssembler:         deniedImportsAssembler,
 	}
 }
 
-func (m *componentsAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+func (m *componentsAssembler) assemble(archSpec *arch.Spec, document spec.Document) error {
+	instances, err := m.templateInstances(document)
+	if err != nil {
+		return err
+	}
+
 	for yamlName, yamlComponent := range document.Components() {
-		component, err := m.assembleComponent(yamlName, yamlComponent, document)
-		if err != nil {
-			return fmt.Errorf("failed to assemble component '%s': %w", yamlName, err)
+		if !spec.IsTemplate(yamlName) {
+			component, err := m.assembleComponent(yamlName, yamlComponent, document, instances)
+			if err != nil {
+				return fmt.Errorf("failed to assemble component '%s': %w", yamlName, err)
+			}
+
+			archSpec.Components = append(archSpec.Components, component)
+			continue
 		}
 
-		spec.Components = append(spec.Components, component)
+		for _, value := range instances[yamlName] {
+			instanceName := spec.ExpandTemplate(yamlName, value)
+			instance := common.NewReferable[spec.Component](
+				templateComponent{Component: yamlComponent.Value, value: value},
+				yamlComponent.Reference,
+			)
+
+			component, err := m.assembleComponent(instanceName, instance, document, instances)
+			if err != nil {
+				return fmt.Errorf("failed to assemble component '%s' (from template '%s'): %w", instanceName, yamlName, err)
+			}
+
+			archSpec.Components = append(archSpec.Components, component)
+		}
 	}
 
 	return nil
 }
 
+// templateInstances return values of all component templates:
+// template name -> placeholder values. Instances, that defined
+// in archfile explicitly, are skipped (explicit component wins)
+func (m *componentsAssembler) templateInstances(document spec.Document) (map[string][]string, error) {
+	instances := make(map[string][]string)
+
+	for yamlName, yamlComponent := range document.Components() {
+		if !spec.IsTemplate(yamlName) {
+			continue
+		}
+
+		values, err := m.resolver.templateValues(document.WorkingDirectory().Value, yamlComponent.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand component template '%s': %w", yamlName, err)
+		}
+
+		for _, value := range values {
+			if _, defined := document.Components()[spec.ExpandTemplate(yamlName, value)]; defined {
+				continue
+			}
+
+			instances[yamlName] = append(instances[yamlName], value)
+		}
+	}
+
+	return instances, nil
+}
+
 func (m *componentsAssembler) assembleComponent(
 	yamlName string,
 	yamlComponent common.Referable[spec.Component],
 	yamlDocument spec.Document,
+	instances map[string][]string,
 ) (arch.Component, error) {
-	depMeta, hasDeps := yamlDocument.Dependencies()[yamlName]
+	depMeta, hasDeps := componentDependencies(yamlDocument, yamlName)
 
 	mayDependOn := make([]common.Referable[string], 0)
 	canUse := make([]common.Referable[string], 0)
+	mayNotDependOn := make([]common.Referable[string], 0)
+	cannotUse := make([]common.Referable[string], 0)
 	deepScan := yamlDocument.Options().DeepScan()
 
 	if hasDeps {
-		mayDependOn = append(mayDependOn, depMeta.Value.MayDependOn()...)
+		mayDependOn = append(mayDependOn, expandTemplateReferences(depMeta.Value.MayDependOn(), instances)...)
 		canUse = append(canUse, depMeta.Value.CanUse()...)
+		mayNotDependOn = append(mayNotDependOn, depMeta.Value.MayNotDependOn()...)
+		cannotUse = append(cannotUse, depMeta.Value.CannotUse()...)
 		deepScan = depMeta.Value.DeepScan()
 	}
 
 	cmp := arch.Component{
 		Name:        common.NewReferable(yamlName, yamlComponent.Reference),
+		Priority:    yamlComponent.Value.Priority(),
 		MayDependOn: mayDependOn,
 		CanUse:      canUse,
 		DeepScan:    deepScan,
+		Common:      isCommonComponent(yamlDocument, yamlName),
 	}
 
 	type enricher func() error
@@ -73,6 +136,11 @@ func (m *componentsAssembler) assembleComponent(
 		func() error { return m.enrichWithResolvedPaths(&cmp, yamlDocument, yamlName, yamlComponent) },
 		func() error { return m.enrichWithProjectImports(&cmp, yamlComponent, yamlDocument, mayDependOn) },
 		func() error { return m.enrichWithVendorGlobs(&cmp, yamlDocument, canUse) },
+		func() error { return m.enrichWithStdlibGlobs(&cmp, yamlDocument, canUse) },
+		func() error { return m.enrichWithDenyRules(&cmp, yamlDocument, mayNotDependOn, cannotUse) },
+		func() error { return m.enrichWithCapabilities(&cmp, hasDeps, depMeta.Value) },
+		func() error { return m.enrichWithExports(&cmp, yamlDocument, yamlComponent) },
+		func() error { return m.enrichWithSymbols(&cmp, hasDeps, depMeta.Value) },
 	}
 
 	for _, enrich := range enrichers {
@@ -117,28 +185,15 @@ func (m *componentsAssembler) enrichWithResolvedPaths(
 	yamlName string,
 	yamlComponent common.Referable[spec.Component],
 ) error {
-	resolvedPaths := make([]common.Referable[models.ResolvedPath], 0)
-
-	for _, componentIn := range yamlComponent.Value.RelativePaths() {
-		tmpResolvedPath, err := m.resolver.resolveLocalGlobPath(
-			path.Clean(fmt.Sprintf("%s/%s",
-				yamlDocument.WorkingDirectory().Value,
-				string(componentIn),
-			)),
-		)
-		if err != nil {
-			return fmt.Errorf("failed to assemble component '%s' path '%s': %w",
-				yamlName,
-				componentIn,
-				err,
-			)
-		}
-
-		wrappedPaths := wrap(yamlComponent.Reference, tmpResolvedPath)
-		resolvedPaths = append(resolvedPaths, wrappedPaths...)
+	resolvedPaths, err := m.resolver.resolveComponentPaths(
+		yamlDocument.WorkingDirectory().Value,
+		yamlComponent.Value.RelativePaths(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component '%s' paths: %w", yamlName, err)
 	}
 
-	cmp.ResolvedPaths = resolvedPaths
+	cmp.ResolvedPaths = wrap(yamlComponent.Reference, resolvedPaths)
 	return nil
 }
 
@@ -170,3 +225,125 @@ func (m *componentsAssembler) enrichWithVendorGlobs(
 	cmp.AllowedVendorGlobs = vendorGlobs
 	return nil
 }
+
+func (m *componentsAssembler) enrichWithStdlibGlobs(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	canUse []common.Referable[string],
+) error {
+	stdlibGlobs, err := m.allowedStdlibImportsAssembler.assemble(yamlDocument, unwrap(canUse))
+	if err != nil {
+		return fmt.Errorf("failed to assemble component stdlib imports: %w", err)
+	}
+
+	cmp.AllowedStdlibGlobs = stdlibGlobs
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithDenyRules(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	mayNotDependOn []common.Referable[string],
+	cannotUse []common.Referable[string],
+) error {
+	projectRules, err := m.deniedImportsAssembler.assembleProject(yamlDocument, mayNotDependOn)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component denied project imports: %w", err)
+	}
+
+	vendorRules, err := m.deniedImportsAssembler.assembleVendor(yamlDocument, cannotUse)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component denied vendor imports: %w", err)
+	}
+
+	stdlibRules, err := m.deniedImportsAssembler.assembleStdlib(yamlDocument, cannotUse)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component denied stdlib imports: %w", err)
+	}
+
+	cmp.DeniedProjectImports = projectRules
+	cmp.DeniedVendorImports = vendorRules
+	cmp.DeniedStdlibImports = stdlibRules
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithCapabilities(
+	cmp *arch.Component,
+	hasDeps bool,
+	depMeta spec.DependencyRule,
+) error {
+	if !hasDeps || depMeta.Capabilities() == nil {
+		cmp.Capabilities = arch.CapabilityPolicy{
+			Restricted: false,
+			Allowed:    []common.Referable[models.Capability]{},
+		}
+		return nil
+	}
+
+	cmp.Capabilities = arch.CapabilityPolicy{
+		Restricted: true,
+		Allowed:    depMeta.Capabilities(),
+	}
+
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithSymbols(
+	cmp *arch.Component,
+	hasDeps bool,
+	depMeta spec.DependencyRule,
+) error {
+	cmp.Symbols = arch.SymbolPolicy{
+		Forbidden: []common.Referable[models.Symbol]{},
+		Allowed:   []common.Referable[models.Symbol]{},
+	}
+
+	if !hasDeps {
+		return nil
+	}
+
+	cmp.Symbols.Forbidden = append(cmp.Symbols.Forbidden, depMeta.ForbidSymbols()...)
+	cmp.Symbols.Allowed = append(cmp.Symbols.Allowed, depMeta.AllowSymbols()...)
+	return nil
+}
+
+func (m *componentsAssembler) enrichWithExports(
+	cmp *arch.Component,
+	yamlDocument spec.Document,
+	yamlComponent common.Referable[spec.Component],
+) error {
+	exports := yamlComponent.Value.Exports()
+	if exports == nil {
+		cmp.Exports = arch.ExportPolicy{
+			Restricted: false,
+			Exported:   []common.Referable[models.ResolvedPath]{},
+		}
+		return nil
+	}
+
+	resolvedPaths, err := m.resolver.resolveComponentPaths(
+		yamlDocument.WorkingDirectory().Value,
+		exports,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to assemble component exports: %w", err)
+	}
+
+	cmp.Exports = arch.ExportPolicy{
+		Restricted: true,
+		Exported:   wrap(yamlComponent.Reference, resolvedPaths),
+	}
+
+	return nil
+}
+
+// isCommonComponent is true, when component (or its template) in commonComponents list
+func isCommonComponent(document spec.Document, name string) bool {
+	for _, commonComponent := range document.CommonComponents() {
+		if spec.MatchComponentName(commonComponent.Value, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,25 @@
+package assembler
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type overridesAssembler struct{}
+
+func newOverridesAssembler() *overridesAssembler {
+	return &overridesAssembler{}
+}
+
+func (oa *overridesAssembler) assemble(spec *arch.Spec, document spec.Document) error {
+	for _, override := range document.Overrides() {
+		spec.Integrity.Suggestions = append(spec.Integrity.Suggestions, arch.Notice{
+			Notice: fmt.Errorf("%s", override.Value),
+			Ref:    override.Reference,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package assembler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/cycles"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type cyclesAssembler struct{}
+
+func newCyclesAssembler() *cyclesAssembler {
+	return &cyclesAssembler{}
+}
+
+func (ca *cyclesAssembler) assemble(spec *arch.Spec, _ spec.Document) error {
+	graph := make(map[string][]string)
+	rules := make(map[string]map[string]common.Reference)
+
+	for _, component := range spec.Components {
+		from := component.Name.Value
+		rules[from] = make(map[string]common.Reference)
+
+		for _, dependency := range component.MayDependOn {
+			graph[from] = append(graph[from], dependency.Value)
+			rules[from][dependency.Value] = dependency.Reference
+		}
+	}
+
+	// every rule of cycle is reported, so all of them can be found in archfile
+	for _, cycle := range cycles.Find(graph) {
+		for ind := 0; ind < len(cycle)-1; ind++ {
+			from, to := cycle[ind], cycle[ind+1]
+
+			spec.Integrity.Suggestions = append(spec.Integrity.Suggestions, arch.Notice{
+				Notice: fmt.Errorf("components dependency cycle '%s': '%s' mayDependOn '%s'",
+					strings.Join(cycle, " -> "),
+					from,
+					to,
+				),
+				Ref: rules[from][to],
+			})
+		}
+	}
+
+	return nil
+}
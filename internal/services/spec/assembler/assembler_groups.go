@@ -0,0 +1,173 @@
+package assembler
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type groupsAssembler struct{}
+
+func newGroupsAssembler() *groupsAssembler {
+	return &groupsAssembler{}
+}
+
+// assemble isolation rules for all components of isolated groups:
+// group component can't import packages of other group components, except
+// public API packages. Isolation never grant imports, they still should be
+// allowed by deps (mayDependOn). Should be called after components assembler
+func (ga *groupsAssembler) assemble(archSpec *arch.Spec, document spec.Document) error {
+	names := make([]string, 0, len(document.Groups()))
+	for name := range document.Groups() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		group := document.Groups()[name]
+		if !group.Value.Isolated().Value {
+			continue
+		}
+
+		err := ga.assembleGroup(archSpec, common.NewReferable(name, group.Reference), group.Value)
+		if err != nil {
+			return fmt.Errorf("failed to assemble group '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (ga *groupsAssembler) assembleGroup(archSpec *arch.Spec, name common.Referable[string], group spec.Group) error {
+	members := make([]int, 0)
+	for ind, component := range archSpec.Components {
+		if groupContains(group, component.Name.Value) {
+			members = append(members, ind)
+		}
+	}
+
+	for _, target := range members {
+		private, err := privatePaths(archSpec.Components[target].ResolvedPaths, group.PublicAPI().Value)
+		if err != nil {
+			return fmt.Errorf("failed to match public api of '%s': %w", archSpec.Components[target].Name.Value, err)
+		}
+
+		for _, member := range members {
+			if member == target {
+				continue
+			}
+
+			component := &archSpec.Components[member]
+			component.IsolatedImports = append(component.IsolatedImports, arch.IsolationRule{
+				Group:         name,
+				ComponentName: archSpec.Components[target].Name.Value,
+				PublicAPI:     group.PublicAPI(),
+				ResolvedPaths: private,
+			})
+		}
+	}
+
+	return nil
+}
+
+func groupContains(group spec.Group, componentName string) bool {
+	for _, pattern := range group.Components() {
+		if spec.MatchComponentName(pattern.Value, componentName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// privatePaths return all component paths, except public API packages.
+// Public API is package, matched by api glob (and all its sub-packages), relative
+// to component root directories (component paths, not nested in other component paths)
+func privatePaths(
+	resolvedPaths []common.Referable[models.ResolvedPath],
+	api string,
+) ([]models.ResolvedPath, error) {
+	private := make([]models.ResolvedPath, 0)
+	roots := componentRoots(resolvedPaths)
+
+	for _, resolvedPath := range resolvedPaths {
+		isPublic, err := matchPublicAPI(roots, resolvedPath.Value, api)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isPublic {
+			private = append(private, resolvedPath.Value)
+		}
+	}
+
+	return private, nil
+}
+
+func componentRoots(resolvedPaths []common.Referable[models.ResolvedPath]) []string {
+	roots := make([]string, 0)
+
+	for _, resolvedPath := range resolvedPaths {
+		directory := packageDirectory(resolvedPath.Value)
+		nested := false
+
+		for _, other := range resolvedPaths {
+			otherDirectory := packageDirectory(other.Value)
+			if otherDirectory != directory && strings.HasPrefix(directory, otherDirectory+"/") {
+				nested = true
+				break
+			}
+		}
+
+		if !nested {
+			roots = append(roots, directory)
+		}
+	}
+
+	return roots
+}
+
+func matchPublicAPI(roots []string, resolvedPath models.ResolvedPath, api string) (bool, error) {
+	if api == "" {
+		return false, nil
+	}
+
+	directory := packageDirectory(resolvedPath)
+
+	for _, root := range roots {
+		if !strings.HasPrefix(directory, root+"/") {
+			continue
+		}
+
+		relative := strings.TrimPrefix(directory, root+"/")
+
+		for _, glob := range []models.Glob{models.Glob(api), models.Glob(api + "/**")} {
+			matched, err := glob.Match(relative)
+			if err != nil {
+				return false, fmt.Errorf("invalid api path '%s': %w", api, err)
+			}
+
+			if matched {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func packageDirectory(resolvedPath models.ResolvedPath) string {
+	directory := strings.TrimSuffix(resolvedPath.LocalPath, "/")
+	if resolvedPath.File {
+		directory = path.Dir(directory)
+	}
+
+	return directory
+}
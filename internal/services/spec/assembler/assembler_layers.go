@@ -0,0 +1,93 @@
+package assembler
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type layersAssembler struct{}
+
+func newLayersAssembler() *layersAssembler {
+	return &layersAssembler{}
+}
+
+// assemble layers and implied dependencies: every component of layer can
+// depend on components of next layer (or any lower layer, when layers is not strict).
+// Should be called after components assembler
+func (la *layersAssembler) assemble(archSpec *arch.Spec, document spec.Document) error {
+	archSpec.Layers = arch.LayerPolicy{
+		Strict: document.StrictLayers(),
+		Layers: make([]arch.Layer, 0, len(document.Layers())),
+	}
+
+	layerOf := make(map[string]int)
+
+	for ind, layerName := range document.Layers() {
+		layer := arch.Layer{
+			Name:       layerName,
+			Components: make([]string, 0),
+		}
+
+		for _, component := range archSpec.Components {
+			if _, exist := layerOf[component.Name.Value]; exist {
+				continue
+			}
+
+			if spec.LayerMatch(document, layerName.Value, component.Name.Value) {
+				layer.Components = append(layer.Components, component.Name.Value)
+				layerOf[component.Name.Value] = ind
+			}
+		}
+
+		archSpec.Layers.Layers = append(archSpec.Layers.Layers, layer)
+	}
+
+	components := make(map[string]arch.Component, len(archSpec.Components))
+	for _, component := range archSpec.Components {
+		components[component.Name.Value] = component
+	}
+
+	for ind := range archSpec.Components {
+		component := &archSpec.Components[ind]
+
+		layerInd, ok := layerOf[component.Name.Value]
+		if !ok {
+			continue
+		}
+
+		for _, lower := range la.allowedLayers(archSpec.Layers, layerInd) {
+			for _, dependencyName := range lower.Components {
+				la.allowDependency(component, components[dependencyName], lower.Name.Reference)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (la *layersAssembler) allowedLayers(policy arch.LayerPolicy, layerInd int) []arch.Layer {
+	if layerInd+1 >= len(policy.Layers) {
+		return nil
+	}
+
+	if policy.Strict.Value {
+		return policy.Layers[layerInd+1 : layerInd+2]
+	}
+
+	return policy.Layers[layerInd+1:]
+}
+
+func (la *layersAssembler) allowDependency(component *arch.Component, dependency arch.Component, ref common.Reference) {
+	for _, name := range component.MayDependOn {
+		if name.Value == dependency.Name.Value {
+			return
+		}
+	}
+
+	component.MayDependOn = append(component.MayDependOn, common.NewReferable(dependency.Name.Value, ref))
+
+	for _, resolvedPath := range dependency.ResolvedPaths {
+		component.AllowedProjectImports = append(component.AllowedProjectImports, common.NewReferable(resolvedPath.Value, ref))
+	}
+}
@@ -0,0 +1,154 @@
+package assembler
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	// templateComponent is one instance of component template,
+	// with placeholder replaced in all "in" paths
+	templateComponent struct {
+		spec.Component
+		value string
+	}
+
+	// templateRule is dependency rule of component template,
+	// with placeholder replaced in all component names
+	templateRule struct {
+		spec.DependencyRule
+		value string
+	}
+)
+
+func (c templateComponent) RelativePaths() []models.Glob {
+	return spec.ExpandTemplateGlobs(c.Component.RelativePaths(), c.value)
+}
+
+func (c templateComponent) Exports() []models.Glob {
+	if c.Component.Exports() == nil {
+		return nil
+	}
+
+	return spec.ExpandTemplateGlobs(c.Component.Exports(), c.value)
+}
+
+func (r templateRule) MayDependOn() []common.Referable[string] {
+	return expandTemplateNames(r.DependencyRule.MayDependOn(), r.value)
+}
+
+func (r templateRule) MayNotDependOn() []common.Referable[string] {
+	return expandTemplateNames(r.DependencyRule.MayNotDependOn(), r.value)
+}
+
+func expandTemplateNames(names []common.Referable[string], value string) []common.Referable[string] {
+	expanded := make([]common.Referable[string], 0, len(names))
+	for _, name := range names {
+		expanded = append(expanded, common.NewReferable(spec.ExpandTemplate(name.Value, value), name.Reference))
+	}
+
+	return expanded
+}
+
+// expandTemplateReferences replace references to component
+// template with references to all template instances
+func expandTemplateReferences(names []common.Referable[string], instances map[string][]string) []common.Referable[string] {
+	expanded := make([]common.Referable[string], 0, len(names))
+	for _, name := range names {
+		if !spec.IsTemplate(name.Value) {
+			expanded = append(expanded, name)
+			continue
+		}
+
+		for _, value := range instances[name.Value] {
+			expanded = append(expanded, common.NewReferable(spec.ExpandTemplate(name.Value, value), name.Reference))
+		}
+	}
+
+	return expanded
+}
+
+// componentRelativePaths return "in" paths of component by name. Name can be:
+//   - usual component
+//   - component template (paths of all template instances)
+//   - instance of component template
+func componentRelativePaths(document spec.Document, name string) ([]models.Glob, bool) {
+	if component, ok := document.Components()[name]; ok {
+		if spec.IsTemplate(name) {
+			return spec.ExpandTemplateGlobs(component.Value.RelativePaths(), "*"), true
+		}
+
+		return component.Value.RelativePaths(), true
+	}
+
+	template, value, ok := spec.FindTemplate(document, name)
+	if !ok {
+		return nil, false
+	}
+
+	return spec.ExpandTemplateGlobs(document.Components()[template].Value.RelativePaths(), value), true
+}
+
+// templateValues find all placeholder values of component template,
+// value is part of path segment, matched by placeholder. Only paths
+// matched by whole glob are used ("modules/{name}/api" will not
+// create instance for "modules/orders", without "api" package)
+func (r *resolver) templateValues(workdir string, template spec.Component) ([]string, error) {
+	unique := make(map[string]struct{})
+
+	for _, glob := range template.RelativePaths() {
+		if glob.IsExclusion() || !strings.Contains(string(glob), spec.TemplateParam) {
+			continue
+		}
+
+		localGlob := path.Clean(fmt.Sprintf("%s/%s", workdir, glob))
+		matcher, err := templateValueMatcher(localGlob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template path '%s': %w", glob, err)
+		}
+
+		resolved, err := r.resolveLocalGlobPath(spec.ExpandTemplate(localGlob, "*"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve template path '%s': %w", glob, err)
+		}
+
+		for _, resolvedPath := range resolved {
+			matches := matcher.FindStringSubmatch(resolvedPath.LocalPath)
+			if len(matches) < 2 {
+				continue
+			}
+
+			unique[matches[1]] = struct{}{}
+		}
+	}
+
+	values := make([]string, 0, len(unique))
+	for value := range unique {
+		values = append(values, value)
+	}
+
+	sort.Strings(values)
+	return values, nil
+}
+
+// templateValueMatcher compile template glob to regexp, value
+// is captured from path segment of first placeholder
+func templateValueMatcher(glob string) (*regexp.Regexp, error) {
+	parts := strings.Split(glob, spec.TemplateParam)
+	for ind, part := range parts {
+		part = regexp.QuoteMeta(part)
+		part = strings.ReplaceAll(part, `/\*\*`, `(/.*)?`)
+		part = strings.ReplaceAll(part, `\*\*`, `.*`)
+		part = strings.ReplaceAll(part, `\*`, `[^/]+`)
+		parts[ind] = part
+	}
+
+	return regexp.Compile(fmt.Sprintf("^%s$", strings.Join(parts, `([^/]+)`)))
+}
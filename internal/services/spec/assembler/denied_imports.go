@@ -0,0 +1,95 @@
+package assembler
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type deniedImportsAssembler struct {
+	resolver *resolver
+}
+
+func newDeniedImportsAssembler(
+	resolver *resolver,
+) *deniedImportsAssembler {
+	return &deniedImportsAssembler{
+		resolver: resolver,
+	}
+}
+
+func (dia *deniedImportsAssembler) assembleProject(
+	yamlDocument spec.Document,
+	componentNames []common.Referable[string],
+) ([]arch.DenyProjectRule, error) {
+	list := make([]arch.DenyProjectRule, 0, len(componentNames))
+
+	for _, name := range componentNames {
+		relativePaths, ok := componentRelativePaths(yamlDocument, name.Value)
+		if !ok {
+			continue
+		}
+
+		rule := arch.DenyProjectRule{
+			ComponentName: name,
+		}
+
+		resolved, err := dia.resolver.resolveComponentPaths(
+			yamlDocument.WorkingDirectory().Value,
+			relativePaths,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve component '%s' paths: %w", name.Value, err)
+		}
+
+		rule.ResolvedPaths = append(rule.ResolvedPaths, resolved...)
+
+		list = append(list, rule)
+	}
+
+	return list, nil
+}
+
+func (dia *deniedImportsAssembler) assembleVendor(
+	yamlDocument spec.Document,
+	vendorNames []common.Referable[string],
+) ([]arch.DenyPackageRule, error) {
+	list := make([]arch.DenyPackageRule, 0, len(vendorNames))
+
+	for _, name := range vendorNames {
+		yamlVendor, ok := yamlDocument.Vendors()[name.Value]
+		if !ok {
+			continue
+		}
+
+		list = append(list, arch.DenyPackageRule{
+			Name:  name,
+			Globs: yamlVendor.Value.ImportPaths(),
+		})
+	}
+
+	return list, nil
+}
+
+func (dia *deniedImportsAssembler) assembleStdlib(
+	yamlDocument spec.Document,
+	stdlibNames []common.Referable[string],
+) ([]arch.DenyPackageRule, error) {
+	list := make([]arch.DenyPackageRule, 0, len(stdlibNames))
+
+	for _, name := range stdlibNames {
+		yamlStdlib, ok := yamlDocument.Stdlib()[name.Value]
+		if !ok {
+			continue
+		}
+
+		list = append(list, arch.DenyPackageRule{
+			Name:  name,
+			Globs: yamlStdlib.Value.ImportPaths(),
+		})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,164 @@
+package assembler
+
+import (
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+// mergedRule is dependency rule of one component, merged from
+// component own rule (first) and all rules keyed by matched glob
+type mergedRule struct {
+	rules []spec.DependencyRule
+	self  string
+}
+
+// componentDependencies return dependency rule of component by name. Rule is merged from:
+//   - component own rule (or rule of component template, for template instances)
+//   - all rules with glob keys (module-*), matched component name
+func componentDependencies(document spec.Document, name string) (common.Referable[spec.DependencyRule], bool) {
+	rules := make([]spec.DependencyRule, 0)
+	reference := common.NewEmptyReference()
+
+	if rule, ok := ownDependencies(document, name); ok {
+		rules = append(rules, rule.Value)
+		reference = rule.Reference
+	}
+
+	globs := make([]string, 0)
+	for key := range document.Dependencies() {
+		if spec.IsRuleGlob(key) && spec.RuleGlobMatch(key, name) {
+			globs = append(globs, key)
+		}
+	}
+
+	sort.Strings(globs)
+	for _, glob := range globs {
+		rule := document.Dependencies()[glob]
+		rules = append(rules, rule.Value)
+
+		if !reference.Valid {
+			reference = rule.Reference
+		}
+	}
+
+	if len(rules) == 0 {
+		return common.Referable[spec.DependencyRule]{}, false
+	}
+
+	return common.NewReferable[spec.DependencyRule](mergedRule{rules: rules, self: name}, reference), true
+}
+
+func ownDependencies(document spec.Document, name string) (common.Referable[spec.DependencyRule], bool) {
+	if rule, ok := document.Dependencies()[name]; ok {
+		return rule, true
+	}
+
+	template, value, ok := spec.FindTemplate(document, name)
+	if !ok {
+		return common.Referable[spec.DependencyRule]{}, false
+	}
+
+	rule, ok := document.Dependencies()[template]
+	if !ok {
+		return common.Referable[spec.DependencyRule]{}, false
+	}
+
+	return common.NewReferable[spec.DependencyRule](templateRule{DependencyRule: rule.Value, value: value}, rule.Reference), true
+}
+
+func (r mergedRule) MayDependOn() []common.Referable[string] {
+	return r.names(spec.DependencyRule.MayDependOn)
+}
+
+func (r mergedRule) CanUse() []common.Referable[string] {
+	return r.merge(spec.DependencyRule.CanUse)
+}
+
+func (r mergedRule) MayNotDependOn() []common.Referable[string] {
+	return r.names(spec.DependencyRule.MayNotDependOn)
+}
+
+func (r mergedRule) CannotUse() []common.Referable[string] {
+	return r.merge(spec.DependencyRule.CannotUse)
+}
+
+func (r mergedRule) Capabilities() []common.Referable[string] {
+	var capabilities []common.Referable[string]
+
+	for _, rule := range r.rules {
+		if rule.Capabilities() == nil {
+			continue
+		}
+
+		if capabilities == nil {
+			capabilities = make([]common.Referable[string], 0)
+		}
+
+		capabilities = append(capabilities, rule.Capabilities()...)
+	}
+
+	return capabilities
+}
+
+func (r mergedRule) ForbidSymbols() []common.Referable[string] {
+	return r.merge(spec.DependencyRule.ForbidSymbols)
+}
+
+func (r mergedRule) AllowSymbols() []common.Referable[string] {
+	return r.merge(spec.DependencyRule.AllowSymbols)
+}
+
+func (r mergedRule) AnyProjectDeps() common.Referable[bool] {
+	return r.any(spec.DependencyRule.AnyProjectDeps)
+}
+
+func (r mergedRule) AnyVendorDeps() common.Referable[bool] {
+	return r.any(spec.DependencyRule.AnyVendorDeps)
+}
+
+func (r mergedRule) DeepScan() common.Referable[bool] {
+	// most specific rule (component own rule) wins
+	return r.rules[0].DeepScan()
+}
+
+// names merge lists of component names, with 'self' replaced by component name
+func (r mergedRule) names(list func(spec.DependencyRule) []common.Referable[string]) []common.Referable[string] {
+	merged := r.merge(list)
+	for ind, name := range merged {
+		if name.Value == spec.SelfComponent {
+			merged[ind] = common.NewReferable(r.self, name.Reference)
+		}
+	}
+
+	return merged
+}
+
+func (r mergedRule) merge(list func(spec.DependencyRule) []common.Referable[string]) []common.Referable[string] {
+	merged := make([]common.Referable[string], 0)
+	exist := make(map[string]struct{})
+
+	for _, rule := range r.rules {
+		for _, name := range list(rule) {
+			if _, ok := exist[name.Value]; ok {
+				continue
+			}
+
+			exist[name.Value] = struct{}{}
+			merged = append(merged, name)
+		}
+	}
+
+	return merged
+}
+
+func (r mergedRule) any(flag func(spec.DependencyRule) common.Referable[bool]) common.Referable[bool] {
+	for _, rule := range r.rules {
+		if value := flag(rule); value.Value {
+			return value
+		}
+	}
+
+	return flag(r.rules[0])
+}
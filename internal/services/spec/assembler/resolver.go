@@ -2,6 +2,7 @@ package assembler
 
 import (
 	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -35,17 +36,67 @@ func (r *resolver) resolveLocalGlobPath(localGlobPath string) ([]models.Resolved
 		return nil, fmt.Errorf("failed to resolve path '%s'", absPath)
 	}
 
+	isFile := models.Glob(localGlobPath).IsFile()
+
 	for _, absResolvedPath := range resolved {
 		localPath := strings.TrimPrefix(absResolvedPath, fmt.Sprintf("%s/", r.rootDirectory))
 		localPath = strings.TrimRight(localPath, "/")
-		importPath := fmt.Sprintf("%s/%s", r.moduleName, localPath)
+
+		packagePath := localPath
+		if isFile {
+			packagePath = path.Dir(localPath)
+		}
+
+		importPath := fmt.Sprintf("%s/%s", r.moduleName, packagePath)
 
 		list = append(list, models.ResolvedPath{
-			ImportPath: strings.TrimRight(importPath, "/"),
+			ImportPath: strings.TrimRight(strings.TrimSuffix(importPath, "/."), "/"),
 			LocalPath:  strings.TrimRight(localPath, "/"),
 			AbsPath:    filepath.Clean(strings.TrimRight(absResolvedPath, "/")),
+			File:       isFile,
 		})
 	}
 
 	return list, nil
 }
+
+// resolveComponentPaths resolve all component "in" globs, relative to workdir.
+// Directories matched by exclusion globs ("!internal/**/mocks")
+// are removed from result
+func (r *resolver) resolveComponentPaths(workdir string, globs []models.Glob) ([]models.ResolvedPath, error) {
+	list := make([]models.ResolvedPath, 0)
+	excluded := make(map[string]struct{})
+
+	for _, glob := range globs {
+		resolved, err := r.resolveLocalGlobPath(
+			path.Clean(fmt.Sprintf("%s/%s", workdir, string(glob.Pattern()))),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve component path '%s': %w", glob, err)
+		}
+
+		if !glob.IsExclusion() {
+			list = append(list, resolved...)
+			continue
+		}
+
+		for _, resolvedPath := range resolved {
+			excluded[resolvedPath.AbsPath] = struct{}{}
+		}
+	}
+
+	if len(excluded) == 0 {
+		return list, nil
+	}
+
+	filtered := make([]models.ResolvedPath, 0, len(list))
+	for _, resolvedPath := range list {
+		if _, ok := excluded[resolvedPath.AbsPath]; ok {
+			continue
+		}
+
+		filtered = append(filtered, resolvedPath)
+	}
+
+	return filtered, nil
+}
@@ -15,15 +15,18 @@ import (
 type Decoder struct {
 	yamlReferenceResolver yamlSourceCodeReferenceResolver
 	jsonSchemaProvider    jsonSchemaProvider
+	presetProvider        presetProvider
 }
 
 func NewDecoder(
 	yamlReferenceResolver yamlSourceCodeReferenceResolver,
 	jsonSchemaProvider jsonSchemaProvider,
+	presetProvider presetProvider,
 ) *Decoder {
 	return &Decoder{
 		yamlReferenceResolver: yamlReferenceResolver,
 		jsonSchemaProvider:    jsonSchemaProvider,
+		presetProvider:        presetProvider,
 	}
 }
 
@@ -55,10 +58,21 @@ func (sp *Decoder) Decode(archFile string) (spec.Document, []arch.Notice, error)
 		return nil, nil, fmt.Errorf("failed to parse arch file (yaml): %w", err)
 	}
 
+	// merge all extended and included archfiles
+	if composable, ok := document.(*ArchV4); ok {
+		schemeNotices = append(schemeNotices, sp.compose(composable, archFile, map[string]struct{}{})...)
+	}
+
+	document.postSetup()
 	return document, schemeNotices, nil
 }
 
 func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath string) (doc, error) {
+	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, filePath)
+	return sp.decodeDocumentContext(decodeCtx, version, sourceCode)
+}
+
+func (sp *Decoder) decodeDocumentContext(decodeCtx context.Context, version int, sourceCode []byte) (doc, error) {
 	reader := bytes.NewBuffer(sourceCode)
 	decoder := yaml.NewDecoder(
 		reader,
@@ -67,7 +81,6 @@ func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath strin
 		yaml.Strict(),
 	)
 
-	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, filePath)
 	document := sp.createEmptyDocumentBeVersion(version)
 
 	err := decoder.DecodeContext(decodeCtx, document)
@@ -75,7 +88,6 @@ func (sp *Decoder) decodeDocument(version int, sourceCode []byte, filePath strin
 		return nil, err
 	}
 
-	document.postSetup()
 	return document, nil
 }
 
@@ -85,10 +97,12 @@ func (sp *Decoder) createEmptyDocumentBeVersion(version int) doc {
 		return &ArchV1{}
 	case 2:
 		return &ArchV2{}
+	case 3:
+		return &ArchV3{}
 	}
 
-	// latest be default (it will be rejected next in spec validator, if version is not v3)
-	return &ArchV3{}
+	// latest be default (it will be rejected next in spec validator, if version is not v4)
+	return &ArchV4{}
 }
 
 func (sp *Decoder) readVersion(sourceCode []byte) (int, error) {
@@ -0,0 +1,306 @@
+package decoder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-yaml/lexer"
+	"github.com/fe3dback/go-yaml/token"
+)
+
+const presetPrefix = "preset:"
+
+var presetParamRegexp = regexp.MustCompile(`\$\{([A-Za-z0-9_-]+)}`)
+
+type (
+	// paramSubstitutions is map of composed source line to "extendsParams"
+	// definition, that was substituted into this line
+	paramSubstitutions map[int]common.Reference
+
+	scalarReplacement struct {
+		line   int
+		column int
+		origin string
+		value  string
+	}
+)
+
+// compose will merge all included and extended archfiles into document
+// included parts merged first, and only after that document extends base,
+// so local (and included) definitions always override base definitions
+func (sp *Decoder) compose(document *ArchV4, filePath string, stack map[string]struct{}) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	stack[filePath] = struct{}{}
+	defer delete(stack, filePath)
+
+	for _, includePath := range document.FInclude {
+		part, partNotices := sp.decodeComposed(includePath, nil, filePath, stack)
+		notices = append(notices, partNotices...)
+
+		if part != nil {
+			notices = append(notices, document.compose(part, composeModeInclude)...)
+		}
+	}
+
+	if document.FExtends.defined {
+		base, baseNotices := sp.decodeComposed(document.FExtends, document.FExtendsParams, filePath, stack)
+		notices = append(notices, baseNotices...)
+
+		if base != nil {
+			notices = append(notices, document.compose(base, composeModeExtend)...)
+		}
+	} else if len(document.FExtendsParams) > 0 {
+		firstParam := document.FExtendsParams[sortedKeys(document.FExtendsParams)[0]]
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("'extendsParams' used without 'extends'"),
+			Ref:    firstParam.ref.Reference,
+		})
+	}
+
+	return notices
+}
+
+// decodeComposed will decode archfile (or embedded preset) referenced from parent archfile
+// all references in decoded document will point to this file
+func (sp *Decoder) decodeComposed(
+	composedPath ref[string],
+	params map[string]ref[string],
+	parentFilePath string,
+	stack map[string]struct{},
+) (*ArchV4, []arch.Notice) {
+	fail := func(err error) (*ArchV4, []arch.Notice) {
+		return nil, []arch.Notice{{
+			Notice: err,
+			Ref:    composedPath.ref.Reference,
+		}}
+	}
+
+	filePath, sourceCode, err := sp.readComposedSource(composedPath.ref.Value, parentFilePath)
+	if err != nil {
+		return fail(err)
+	}
+
+	if _, ok := stack[filePath]; ok {
+		return fail(fmt.Errorf("circular archfile composition: '%s' already used in this chain", composedPath.ref.Value))
+	}
+
+	sourceCode, substitutions, paramNotices := sp.substituteParams(sourceCode, params, composedPath)
+	if len(paramNotices) > 0 {
+		return nil, paramNotices
+	}
+
+	documentVersion, err := sp.readVersion(sourceCode)
+	if err != nil {
+		return fail(fmt.Errorf("failed to read 'version' from composed archfile '%s': %w", composedPath.ref.Value, err))
+	}
+
+	if documentVersion != 4 {
+		return fail(fmt.Errorf("composed archfile '%s' should have version 4, got %d", composedPath.ref.Value, documentVersion))
+	}
+
+	schemeNotices := sp.jsonSchemeValidate(documentVersion, sourceCode, filePath)
+	if len(schemeNotices) > 0 {
+		return nil, attributeComposedNotices(schemeNotices, filePath, substitutions, composedPath)
+	}
+
+	document, err := sp.decodeComposedDocument(documentVersion, sourceCode, filePath, substitutions)
+	if err != nil {
+		return fail(fmt.Errorf("failed to parse composed archfile '%s': %w", composedPath.ref.Value, err))
+	}
+
+	composable, ok := document.(*ArchV4)
+	if !ok {
+		return fail(fmt.Errorf("composed archfile '%s' has unexpected type %T", composedPath.ref.Value, document))
+	}
+
+	return composable, sp.compose(composable, filePath, stack)
+}
+
+func (sp *Decoder) readComposedSource(composedPath string, parentFilePath string) (string, []byte, error) {
+	if strings.HasPrefix(composedPath, presetPrefix) {
+		sourceCode, err := sp.presetProvider.Provide(strings.TrimPrefix(composedPath, presetPrefix))
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to provide preset: %w", err)
+		}
+
+		return composedPath, sourceCode, nil
+	}
+
+	filePath := composedPath
+	if !filepath.IsAbs(filePath) {
+		filePath = filepath.Join(filepath.Dir(parentFilePath), filePath)
+	}
+
+	sourceCode, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read composed archfile '%s': %w", composedPath, err)
+	}
+
+	return filePath, sourceCode, nil
+}
+
+// decodeComposedDocument is same as decodeDocument, but all values
+// substituted from "extendsParams" will reference param definition in parent archfile
+func (sp *Decoder) decodeComposedDocument(version int, sourceCode []byte, filePath string, substitutions paramSubstitutions) (doc, error) {
+	decodeCtx := context.WithValue(context.Background(), yamlParentFileCtx{}, filePath)
+	decodeCtx = context.WithValue(decodeCtx, yamlSubstitutionsCtx{}, substitutions)
+
+	return sp.decodeDocumentContext(decodeCtx, version, sourceCode)
+}
+
+// substituteParams will replace all ${param} placeholders in yaml scalars
+// with values from "extendsParams", every placeholder should have value and
+// every value should be used at least once.
+// Every scalar with placeholder is rewritten as double-quoted string, so
+// values are always decoded as is (for example "**/zz" is not yaml alias)
+func (sp *Decoder) substituteParams(
+	sourceCode []byte,
+	params map[string]ref[string],
+	composedPath ref[string],
+) ([]byte, paramSubstitutions, []arch.Notice) {
+	notices := make([]arch.Notice, 0)
+	substitutions := make(paramSubstitutions)
+	used := make(map[string]struct{}, len(params))
+	missing := make(map[string]struct{})
+
+	lines := strings.Split(string(sourceCode), "\n")
+	replacements := make([]scalarReplacement, 0)
+
+	for _, tok := range lexer.Tokenize(string(sourceCode)) {
+		if !isScalarToken(tok) || !presetParamRegexp.MatchString(tok.Value) {
+			continue
+		}
+
+		var firstParam *ref[string]
+		value := presetParamRegexp.ReplaceAllStringFunc(tok.Value, func(placeholder string) string {
+			name := presetParamRegexp.FindStringSubmatch(placeholder)[1]
+
+			param, ok := params[name]
+			if !ok {
+				missing[name] = struct{}{}
+				return placeholder
+			}
+
+			used[name] = struct{}{}
+			if firstParam == nil {
+				firstParam = &param
+			}
+
+			return param.ref.Value
+		})
+
+		if firstParam == nil {
+			continue
+		}
+
+		replacements = append(replacements, scalarReplacement{
+			line:   tok.Position.Line,
+			column: tok.Position.Column,
+			origin: strings.TrimSpace(tok.Origin),
+			value:  strconv.Quote(value),
+		})
+
+		if _, exist := substitutions[tok.Position.Line]; !exist {
+			substitutions[tok.Position.Line] = firstParam.ref.Reference
+		}
+	}
+
+	// replace from the end, so columns of previous scalars on same line stay valid
+	sort.Slice(replacements, func(i, j int) bool {
+		if replacements[i].line != replacements[j].line {
+			return replacements[i].line > replacements[j].line
+		}
+
+		return replacements[i].column > replacements[j].column
+	})
+
+	for _, replacement := range replacements {
+		lineIndex := replacement.line - 1
+		if lineIndex < 0 || lineIndex >= len(lines) {
+			continue
+		}
+
+		line := []rune(lines[lineIndex])
+		from := replacement.column - 1
+		to := from + len([]rune(replacement.origin))
+		if from < 0 || to > len(line) || string(line[from:to]) != replacement.origin {
+			continue
+		}
+
+		lines[lineIndex] = string(line[:from]) + replacement.value + string(line[to:])
+	}
+
+	for _, name := range sortedKeys(missing) {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("param '%s' required by '%s' is not defined in 'extendsParams'", name, composedPath.ref.Value),
+			Ref:    composedPath.ref.Reference,
+		})
+	}
+
+	for _, name := range sortedKeys(params) {
+		if _, ok := used[name]; ok {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("param '%s' is not used by '%s'", name, composedPath.ref.Value),
+			Ref:    params[name].ref.Reference,
+		})
+	}
+
+	return []byte(strings.Join(lines, "\n")), substitutions, notices
+}
+
+// attributeComposedNotices will point notices to the parent archfile, when
+// they are caused by substituted param, or composed source is embedded preset
+// (presets not exist on disk, so code preview is not possible)
+func attributeComposedNotices(
+	notices []arch.Notice,
+	filePath string,
+	substitutions paramSubstitutions,
+	composedPath ref[string],
+) []arch.Notice {
+	isPreset := strings.HasPrefix(filePath, presetPrefix)
+
+	for ind, notice := range notices {
+		if notice.Ref.Valid && notice.Ref.File == filePath {
+			if paramRef, ok := substitutions[notice.Ref.Line]; ok {
+				notices[ind].Ref = paramRef
+				continue
+			}
+		}
+
+		if isPreset || !notice.Ref.Valid {
+			notices[ind].Ref = composedPath.ref.Reference
+		}
+	}
+
+	return notices
+}
+
+func isScalarToken(tok *token.Token) bool {
+	switch tok.Type {
+	case token.StringType, token.DoubleQuoteType, token.SingleQuoteType:
+		return true
+	default:
+		return false
+	}
+}
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -75,6 +75,14 @@ func (a *ArchV1) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV1) Stdlib() spec.Stdlib {
+	return spec.Stdlib{}
+}
+
+func (a *ArchV1) CommonStdlib() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a *ArchV1) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -97,12 +105,44 @@ func (a *ArchV1) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV1) Groups() spec.Groups {
+	return spec.Groups{}
+}
+
+func (a *ArchV1) Layers() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV1) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a *ArchV1) Overrides() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 // --
 
 func (a ArchV1Allow) IsDependOnAnyVendor() common.Referable[bool] {
 	return castRef(a.FDepOnAnyVendor)
 }
 
+func (a ArchV1Allow) IsDependOnAnyStdlib() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV1Allow) OwnInterfaces() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV1Allow) Cycles() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV1Allow) Leaks() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
 func (a ArchV1Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
@@ -119,6 +159,14 @@ func (a ArchV1Component) RelativePaths() []models.Glob {
 	return []models.Glob{models.Glob(a.FLocalPath)}
 }
 
+func (a ArchV1Component) Priority() common.Referable[int] {
+	return common.NewEmptyReferable(0)
+}
+
+func (a ArchV1Component) Exports() []models.Glob {
+	return nil
+}
+
 // --
 
 func (a ArchV1Rule) MayDependOn() []common.Referable[string] {
@@ -129,6 +177,26 @@ func (a ArchV1Rule) CanUse() []common.Referable[string] {
 	return castRefList(a.FCanUse)
 }
 
+func (a ArchV1Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) Capabilities() []common.Referable[string] {
+	return nil
+}
+
+func (a ArchV1Rule) ForbidSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV1Rule) AllowSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a ArchV1Rule) AnyProjectDeps() common.Referable[bool] {
 	return castRef(a.FAnyProjectDeps)
 }
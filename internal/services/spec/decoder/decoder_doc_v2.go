@@ -85,6 +85,14 @@ func (a *ArchV2) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV2) Stdlib() spec.Stdlib {
+	return spec.Stdlib{}
+}
+
+func (a *ArchV2) CommonStdlib() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a *ArchV2) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -107,12 +115,44 @@ func (a *ArchV2) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV2) Groups() spec.Groups {
+	return spec.Groups{}
+}
+
+func (a *ArchV2) Layers() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV2) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a *ArchV2) Overrides() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 // --
 
 func (a ArchV2Allow) IsDependOnAnyVendor() common.Referable[bool] {
 	return castRef(a.FDepOnAnyVendor)
 }
 
+func (a ArchV2Allow) IsDependOnAnyStdlib() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV2Allow) OwnInterfaces() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV2Allow) Cycles() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV2Allow) Leaks() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
 func (a ArchV2Allow) DeepScan() common.Referable[bool] {
 	return common.NewEmptyReferable(false)
 }
@@ -141,6 +181,14 @@ func (a ArchV2Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV2Component) Priority() common.Referable[int] {
+	return common.NewEmptyReferable(0)
+}
+
+func (a ArchV2Component) Exports() []models.Glob {
+	return nil
+}
+
 // --
 
 func (a ArchV2Rule) MayDependOn() []common.Referable[string] {
@@ -151,6 +199,26 @@ func (a ArchV2Rule) CanUse() []common.Referable[string] {
 	return castRefList(a.FCanUse)
 }
 
+func (a ArchV2Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) Capabilities() []common.Referable[string] {
+	return nil
+}
+
+func (a ArchV2Rule) ForbidSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV2Rule) AllowSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a ArchV2Rule) AnyProjectDeps() common.Referable[bool] {
 	return castRef(a.FAnyProjectDeps)
 }
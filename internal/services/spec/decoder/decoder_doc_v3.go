@@ -101,6 +101,14 @@ func (a *ArchV3) CommonVendors() []common.Referable[string] {
 	return castRefList(a.FCommonVendors)
 }
 
+func (a *ArchV3) Stdlib() spec.Stdlib {
+	return spec.Stdlib{}
+}
+
+func (a *ArchV3) CommonStdlib() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a *ArchV3) Components() spec.Components {
 	casted := make(spec.Components, len(a.FComponents))
 	for name, cmp := range a.FComponents {
@@ -123,12 +131,44 @@ func (a *ArchV3) Dependencies() spec.Dependencies {
 	return casted
 }
 
+func (a *ArchV3) Groups() spec.Groups {
+	return spec.Groups{}
+}
+
+func (a *ArchV3) Layers() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a *ArchV3) StrictLayers() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a *ArchV3) Overrides() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 // --
 
 func (a ArchV3Allow) IsDependOnAnyVendor() common.Referable[bool] {
 	return castRef(a.FDepOnAnyVendor)
 }
 
+func (a ArchV3Allow) IsDependOnAnyStdlib() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV3Allow) OwnInterfaces() common.Referable[bool] {
+	return common.NewEmptyReferable(false)
+}
+
+func (a ArchV3Allow) Cycles() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV3Allow) Leaks() common.Referable[bool] {
+	return common.NewEmptyReferable(true)
+}
+
 func (a ArchV3Allow) DeepScan() common.Referable[bool] {
 	if a.FDeepScan.defined {
 		return a.FDeepScan.ref
@@ -162,6 +202,14 @@ func (a ArchV3Component) RelativePaths() []models.Glob {
 	return casted
 }
 
+func (a ArchV3Component) Priority() common.Referable[int] {
+	return common.NewEmptyReferable(0)
+}
+
+func (a ArchV3Component) Exports() []models.Glob {
+	return nil
+}
+
 // --
 
 func (a ArchV3Rule) MayDependOn() []common.Referable[string] {
@@ -172,6 +220,26 @@ func (a ArchV3Rule) CanUse() []common.Referable[string] {
 	return castRefList(a.FCanUse)
 }
 
+func (a ArchV3Rule) MayNotDependOn() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) CannotUse() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) Capabilities() []common.Referable[string] {
+	return nil
+}
+
+func (a ArchV3Rule) ForbidSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
+func (a ArchV3Rule) AllowSymbols() []common.Referable[string] {
+	return []common.Referable[string]{}
+}
+
 func (a ArchV3Rule) AnyProjectDeps() common.Referable[bool] {
 	return castRef(a.FAnyProjectDeps)
 }
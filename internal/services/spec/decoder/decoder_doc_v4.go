@@ -0,0 +1,364 @@
+package decoder
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	// ArchV4 changes since ArchV3:
+	// - added deny lists "mayNotDependOn" and "cannotUse" in deps rules
+	//   (deny rules take precedence over any allow rules)
+	// - added "stdlib" section, "commonStdlib" and "allow.depOnAnyStdlib" option
+	//   for restricting go standard library imports
+	// - added "capabilities" allow-list in deps rules
+	// - added "extends" and "include" for composition of archfiles
+	// - added "extendsParams" for extending embedded presets ("extends: preset:hexagonal")
+	// - added "groups" section with isolation of components
+	// - added "exports" of components (public packages for other components)
+	// - added "layers" section with ordered list of layers
+	// - added "forbidSymbols" and "allowSymbols" in deps rules
+	ArchV4 struct {
+		FVersion            ref[int]                                    `json:"version"`
+		FExtends            ref[string]                                 `json:"extends"`
+		FExtendsParams      map[string]ref[string]                      `json:"extendsParams"`
+		FInclude            []ref[string]                               `json:"include"`
+		FWorkDir            ref[string]                                 `json:"workdir"`
+		FAllow              ArchV4Allow                                 `json:"allow"`
+		FExclude            []ref[string]                               `json:"exclude"`
+		FExcludeFilesRegExp []ref[string]                               `json:"excludeFiles"`
+		FVendors            map[spec.VendorName]ref[ArchV4Vendor]       `json:"vendors"`
+		FCommonVendors      []ref[string]                               `json:"commonVendors"`
+		FStdlib             map[spec.StdlibName]ref[ArchV4Stdlib]       `json:"stdlib"`
+		FCommonStdlib       []ref[string]                               `json:"commonStdlib"`
+		FComponents         map[spec.ComponentName]ref[ArchV4Component] `json:"components"`
+		FCommonComponents   []ref[string]                               `json:"commonComponents"`
+		FDependencies       map[spec.ComponentName]ref[ArchV4Rule]      `json:"deps"`
+		FGroups             map[spec.GroupName]ref[ArchV4Group]         `json:"groups"`
+		FLayers             ref[ArchV4Layers]                           `json:"layers"`
+
+		// definitions from extended archfiles, overridden by this document
+		composeOverrides []common.Referable[string]
+	}
+
+	ArchV4Allow struct {
+		FDepOnAnyVendor ref[bool] `json:"depOnAnyVendor"`
+		FDeepScan       ref[bool] `json:"deepScan"`
+		FDepOnAnyStdlib ref[bool] `json:"depOnAnyStdlib"`
+		FOwnInterfaces  ref[bool] `json:"ownInterfaces"`
+		FCycles         ref[bool] `json:"cycles"`
+		FLeaks          ref[bool] `json:"leaks"`
+	}
+
+	ArchV4Vendor struct {
+		FImportPaths stringList `json:"in"`
+	}
+
+	ArchV4Stdlib struct {
+		FImportPaths stringList `json:"in"`
+	}
+
+	ArchV4Component struct {
+		FLocalPaths stringList `json:"in"`
+		FPriority   ref[int]   `json:"priority"`
+		FExports    stringList `json:"exports"`
+	}
+
+	ArchV4Group struct {
+		FComponents []ref[string] `json:"components"`
+		FIsolated   ref[bool]     `json:"isolated"`
+		FPublicAPI  ref[string]   `json:"api"`
+	}
+
+	ArchV4Layers struct {
+		FOrder  []ref[string] `json:"order"`
+		FStrict ref[bool]     `json:"strict"`
+	}
+
+	ArchV4Rule struct {
+		FMayDependOn    []ref[string]      `json:"mayDependOn"`
+		FCanUse         []ref[string]      `json:"canUse"`
+		FMayNotDependOn []ref[string]      `json:"mayNotDependOn"`
+		FCannotUse      []ref[string]      `json:"cannotUse"`
+		FCapabilities   ref[[]ref[string]] `json:"capabilities"`
+		FForbidSymbols  []ref[string]      `json:"forbidSymbols"`
+		FAllowSymbols   []ref[string]      `json:"allowSymbols"`
+		FAnyProjectDeps ref[bool]          `json:"anyProjectDeps"`
+		FAnyVendorDeps  ref[bool]          `json:"anyVendorDeps"`
+		FDeepScan       ref[bool]          `json:"deepScan"`
+	}
+)
+
+func (a *ArchV4) postSetup() {
+	// deep scan nesting (global settings -> local settings)
+	for depName := range a.FDependencies {
+		localDeepScan := a.FDependencies[depName].ref.Value.FDeepScan
+
+		if !localDeepScan.defined {
+			dep := a.FDependencies[depName]
+			dep.ref.Value.FDeepScan = ref[bool]{
+				defined: true,
+				ref:     a.FAllow.DeepScan(),
+			}
+
+			a.FDependencies[depName] = dep
+		}
+	}
+}
+
+func (a *ArchV4) Version() common.Referable[int] {
+	return castRef(a.FVersion)
+}
+
+func (a *ArchV4) WorkingDirectory() common.Referable[string] {
+	// fallback from version 1
+	actualWorkDirectory := "./"
+
+	if a.FWorkDir.ref.Value != "" {
+		actualWorkDirectory = a.FWorkDir.ref.Value
+	}
+
+	return common.NewReferable(actualWorkDirectory, a.FWorkDir.ref.Reference)
+}
+
+func (a *ArchV4) Options() spec.Options {
+	return a.FAllow
+}
+
+func (a *ArchV4) ExcludedDirectories() []common.Referable[string] {
+	return castRefList(a.FExclude)
+}
+
+func (a *ArchV4) ExcludedFilesRegExp() []common.Referable[string] {
+	return castRefList(a.FExcludeFilesRegExp)
+}
+
+func (a *ArchV4) Vendors() spec.Vendors {
+	casted := make(spec.Vendors, len(a.FVendors))
+	for name, vendor := range a.FVendors {
+		casted[name] = common.NewReferable(spec.Vendor(vendor.ref.Value), vendor.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) CommonVendors() []common.Referable[string] {
+	return castRefList(a.FCommonVendors)
+}
+
+func (a *ArchV4) Stdlib() spec.Stdlib {
+	casted := make(spec.Stdlib, len(a.FStdlib))
+	for name, stdlib := range a.FStdlib {
+		casted[name] = common.NewReferable(spec.StdlibPackages(stdlib.ref.Value), stdlib.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) CommonStdlib() []common.Referable[string] {
+	return castRefList(a.FCommonStdlib)
+}
+
+func (a *ArchV4) Components() spec.Components {
+	casted := make(spec.Components, len(a.FComponents))
+	for name, cmp := range a.FComponents {
+		casted[name] = common.NewReferable(spec.Component(cmp.ref.Value), cmp.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) CommonComponents() []common.Referable[string] {
+	return castRefList(a.FCommonComponents)
+}
+
+func (a *ArchV4) Dependencies() spec.Dependencies {
+	casted := make(spec.Dependencies, len(a.FDependencies))
+	for name, dep := range a.FDependencies {
+		casted[name] = common.NewReferable(spec.DependencyRule(dep.ref.Value), dep.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) Groups() spec.Groups {
+	casted := make(spec.Groups, len(a.FGroups))
+	for name, group := range a.FGroups {
+		casted[name] = common.NewReferable(spec.Group(group.ref.Value), group.ref.Reference)
+	}
+
+	return casted
+}
+
+func (a *ArchV4) Layers() []common.Referable[string] {
+	return castRefList(a.FLayers.ref.Value.FOrder)
+}
+
+func (a *ArchV4) StrictLayers() common.Referable[bool] {
+	return castRef(a.FLayers.ref.Value.FStrict)
+}
+
+func (a *ArchV4) Overrides() []common.Referable[string] {
+	return a.composeOverrides
+}
+
+// --
+
+func (a ArchV4Allow) IsDependOnAnyVendor() common.Referable[bool] {
+	return castRef(a.FDepOnAnyVendor)
+}
+
+func (a ArchV4Allow) DeepScan() common.Referable[bool] {
+	if a.FDeepScan.defined {
+		return a.FDeepScan.ref
+	}
+
+	// be default it`s on from V3+
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV4Allow) IsDependOnAnyStdlib() common.Referable[bool] {
+	if a.FDepOnAnyStdlib.defined {
+		return a.FDepOnAnyStdlib.ref
+	}
+
+	// by default any stdlib package is allowed
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV4Allow) OwnInterfaces() common.Referable[bool] {
+	return castRef(a.FOwnInterfaces)
+}
+
+func (a ArchV4Allow) Cycles() common.Referable[bool] {
+	if a.FCycles.defined {
+		return a.FCycles.ref
+	}
+
+	// by default cycles between components are allowed in code
+	return common.NewEmptyReferable(true)
+}
+
+func (a ArchV4Allow) Leaks() common.Referable[bool] {
+	if a.FLeaks.defined {
+		return a.FLeaks.ref
+	}
+
+	// by default exported API can mention any types
+	return common.NewEmptyReferable(true)
+}
+
+// --
+
+func (a ArchV4Vendor) ImportPaths() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FImportPaths))
+
+	for _, path := range a.FImportPaths {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4Stdlib) ImportPaths() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FImportPaths))
+
+	for _, path := range a.FImportPaths {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4Component) RelativePaths() []models.Glob {
+	casted := make([]models.Glob, 0, len(a.FLocalPaths))
+
+	for _, path := range a.FLocalPaths {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+func (a ArchV4Component) Priority() common.Referable[int] {
+	return castRef(a.FPriority)
+}
+
+func (a ArchV4Component) Exports() []models.Glob {
+	if len(a.FExports) == 0 {
+		return nil
+	}
+
+	casted := make([]models.Glob, 0, len(a.FExports))
+
+	for _, path := range a.FExports {
+		casted = append(casted, models.Glob(path))
+	}
+
+	return casted
+}
+
+// --
+
+func (a ArchV4Group) Components() []common.Referable[string] {
+	return castRefList(a.FComponents)
+}
+
+func (a ArchV4Group) Isolated() common.Referable[bool] {
+	return castRef(a.FIsolated)
+}
+
+func (a ArchV4Group) PublicAPI() common.Referable[string] {
+	return castRef(a.FPublicAPI)
+}
+
+// --
+
+func (a ArchV4Rule) MayDependOn() []common.Referable[string] {
+	return castRefList(a.FMayDependOn)
+}
+
+func (a ArchV4Rule) CanUse() []common.Referable[string] {
+	return castRefList(a.FCanUse)
+}
+
+func (a ArchV4Rule) MayNotDependOn() []common.Referable[string] {
+	return castRefList(a.FMayNotDependOn)
+}
+
+func (a ArchV4Rule) CannotUse() []common.Referable[string] {
+	return castRefList(a.FCannotUse)
+}
+
+func (a ArchV4Rule) Capabilities() []common.Referable[string] {
+	if !a.FCapabilities.defined {
+		return nil
+	}
+
+	return castRefList(a.FCapabilities.ref.Value)
+}
+
+func (a ArchV4Rule) ForbidSymbols() []common.Referable[string] {
+	return castRefList(a.FForbidSymbols)
+}
+
+func (a ArchV4Rule) AllowSymbols() []common.Referable[string] {
+	return castRefList(a.FAllowSymbols)
+}
+
+func (a ArchV4Rule) AnyProjectDeps() common.Referable[bool] {
+	return castRef(a.FAnyProjectDeps)
+}
+
+func (a ArchV4Rule) AnyVendorDeps() common.Referable[bool] {
+	return castRef(a.FAnyVendorDeps)
+}
+
+func (a ArchV4Rule) DeepScan() common.Referable[bool] {
+	return a.FDeepScan.ref
+}
@@ -0,0 +1,141 @@
+package decoder
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+)
+
+// composition of archfiles (v4+):
+//   extends - base document, every definition from current document
+//             will override base definition with same name
+//   include - document part, every name should be defined only once
+//             between current document and all included parts
+
+const (
+	composeModeExtend composeMode = iota
+	composeModeInclude
+)
+
+type (
+	composeMode uint8
+
+	composer struct {
+		mode      composeMode
+		notices   []arch.Notice
+		overrides []common.Referable[string]
+	}
+)
+
+func (a *ArchV4) compose(other *ArchV4, mode composeMode) []arch.Notice {
+	c := &composer{
+		mode:      mode,
+		notices:   make([]arch.Notice, 0),
+		overrides: make([]common.Referable[string], 0),
+	}
+
+	composeScalar(c, "workdir", &a.FWorkDir, other.FWorkDir)
+	composeScalar(c, "allow.depOnAnyVendor", &a.FAllow.FDepOnAnyVendor, other.FAllow.FDepOnAnyVendor)
+	composeScalar(c, "allow.depOnAnyStdlib", &a.FAllow.FDepOnAnyStdlib, other.FAllow.FDepOnAnyStdlib)
+	composeScalar(c, "allow.deepScan", &a.FAllow.FDeepScan, other.FAllow.FDeepScan)
+	composeScalar(c, "allow.ownInterfaces", &a.FAllow.FOwnInterfaces, other.FAllow.FOwnInterfaces)
+	composeScalar(c, "allow.cycles", &a.FAllow.FCycles, other.FAllow.FCycles)
+	composeScalar(c, "allow.leaks", &a.FAllow.FLeaks, other.FAllow.FLeaks)
+	composeScalar(c, "layers", &a.FLayers, other.FLayers)
+
+	a.FExclude = composeList(a.FExclude, other.FExclude)
+	a.FExcludeFilesRegExp = composeList(a.FExcludeFilesRegExp, other.FExcludeFilesRegExp)
+	a.FCommonVendors = composeList(a.FCommonVendors, other.FCommonVendors)
+	a.FCommonStdlib = composeList(a.FCommonStdlib, other.FCommonStdlib)
+	a.FCommonComponents = composeList(a.FCommonComponents, other.FCommonComponents)
+
+	a.FVendors = composeMap(c, "vendor", a.FVendors, other.FVendors)
+	a.FStdlib = composeMap(c, "stdlib", a.FStdlib, other.FStdlib)
+	a.FComponents = composeMap(c, "component", a.FComponents, other.FComponents)
+	a.FDependencies = composeMap(c, "deps of", a.FDependencies, other.FDependencies)
+	a.FGroups = composeMap(c, "group", a.FGroups, other.FGroups)
+
+	a.composeOverrides = append(a.composeOverrides, other.composeOverrides...)
+	a.composeOverrides = append(a.composeOverrides, c.overrides...)
+
+	return c.notices
+}
+
+// conflict called when same definition exist in both documents
+// current document definition always stays, so for extend mode
+// this is expected override behavior
+func (c *composer) conflict(name string, current, other common.Reference) {
+	if c.mode == composeModeExtend {
+		c.overrides = append(c.overrides, common.NewReferable(
+			fmt.Sprintf("%s from '%s' overridden", name, other.String()),
+			current,
+		))
+		return
+	}
+
+	c.notices = append(c.notices, arch.Notice{
+		Notice: fmt.Errorf("%s defined in both '%s' and '%s' (included archfiles should not redefine same names)",
+			name,
+			current.String(),
+			other.String(),
+		),
+		Ref: other,
+	})
+}
+
+func composeScalar[T any](c *composer, name string, current *ref[T], other ref[T]) {
+	if !other.defined {
+		return
+	}
+
+	if !current.defined {
+		*current = other
+		return
+	}
+
+	c.conflict(fmt.Sprintf("option '%s'", name), current.ref.Reference, other.ref.Reference)
+}
+
+func composeList(current, other []ref[string]) []ref[string] {
+	exist := make(map[string]struct{}, len(current))
+	for _, value := range current {
+		exist[value.ref.Value] = struct{}{}
+	}
+
+	for _, value := range other {
+		if _, ok := exist[value.ref.Value]; ok {
+			continue
+		}
+
+		exist[value.ref.Value] = struct{}{}
+		current = append(current, value)
+	}
+
+	return current
+}
+
+func composeMap[T any](c *composer, section string, current, other map[string]ref[T]) map[string]ref[T] {
+	if current == nil {
+		current = make(map[string]ref[T], len(other))
+	}
+
+	names := make([]string, 0, len(other))
+	for name := range other {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if currentValue, ok := current[name]; ok {
+			c.conflict(fmt.Sprintf("%s '%s'", section, name), currentValue.ref.Reference, other[name].ref.Reference)
+			continue
+		}
+
+		current[name] = other[name]
+	}
+
+	return current
+}
@@ -15,6 +15,7 @@ type ref[T any] struct {
 
 type stringList []string
 type yamlParentFileCtx struct{}
+type yamlSubstitutionsCtx struct{}
 
 func (r *ref[T]) UnmarshalYAML(ctx context.Context, node ast.Node, decode func(interface{}) error) error {
 	filePath := ""
@@ -29,6 +30,12 @@ func (r *ref[T]) UnmarshalYAML(ctx context.Context, node ast.Node, decode func(i
 		node.GetToken().Position.Column,
 	)
 
+	if substitutions, ok := ctx.Value(yamlSubstitutionsCtx{}).(paramSubstitutions); ok {
+		if paramRef, substituted := substitutions[node.GetToken().Position.Line]; substituted {
+			r.ref.Reference = paramRef
+		}
+	}
+
 	return decode(&r.ref.Value)
 }
 
@@ -12,4 +12,8 @@ type (
 	jsonSchemaProvider interface {
 		Provide(version int) ([]byte, error)
 	}
+
+	presetProvider interface {
+		Provide(name string) ([]byte, error)
+	}
 )
@@ -19,9 +19,17 @@ type (
 	// VendorName is abstraction useful for mapping real vendor packages to one Vendor.
 	VendorName = string
 
+	// StdlibName is abstraction useful for mapping go standard library packages to one Stdlib entry.
+	StdlibName = string
+
+	// GroupName is abstraction useful for describing rules between several Components at once.
+	GroupName = string
+
 	Vendors      = map[VendorName]common.Referable[Vendor]
+	Stdlib       = map[StdlibName]common.Referable[StdlibPackages]
 	Components   = map[ComponentName]common.Referable[Component]
 	Dependencies = map[ComponentName]common.Referable[DependencyRule]
+	Groups       = map[GroupName]common.Referable[Group]
 
 	Document interface {
 		// Version of spec (scheme of document)
@@ -53,6 +61,12 @@ type (
 		// CommonVendors is list of Vendors that can be imported to any project package
 		CommonVendors() []common.Referable[string]
 
+		// Stdlib (map) of named go standard library package groups
+		Stdlib() Stdlib
+
+		// CommonStdlib is list of Stdlib names that can be imported to any project package
+		CommonStdlib() []common.Referable[string]
+
 		// Components (map)
 		Components() Components
 
@@ -61,6 +75,22 @@ type (
 
 		// Dependencies map between Components and DependencyRule`s
 		Dependencies() Dependencies
+
+		// Groups (map) of named Component sets with shared rules
+		Groups() Groups
+
+		// Layers is ordered list of architecture layers (from top to bottom), each layer
+		// is Component name (or template, glob of names) or Group name. Upper layers
+		// can depend on lower layers, without describing it in Dependencies
+		Layers() []common.Referable[string]
+
+		// StrictLayers allow layer to depend only on next (lower) layer,
+		// otherwise layer can depend on any lower layer
+		StrictLayers() common.Referable[bool]
+
+		// Overrides is list of definitions from extended archfiles (or presets),
+		// that was overridden by this document. Each reference point to overriding definition
+		Overrides() []common.Referable[string]
 	}
 
 	Options interface {
@@ -68,9 +98,27 @@ type (
 		// analyze will not check imports with not local namespace's
 		IsDependOnAnyVendor() common.Referable[bool]
 
+		// IsDependOnAnyStdlib allows all project code depend on any go standard library package
+		// this is default behavior, when disabled only Stdlib from CanUse and CommonStdlib are allowed
+		IsDependOnAnyStdlib() common.Referable[bool]
+
 		// DeepScan turn on usage of advanced AST linter
 		// this is default behavior since v3+ configs
 		DeepScan() common.Referable[bool]
+
+		// OwnInterfaces require (with DeepScan), that interfaces of injected
+		// dependencies declared in consumer component (or in CommonComponents),
+		// not in component of implementation
+		OwnInterfaces() common.Referable[bool]
+
+		// Cycles allows cycles between components in code (import graph),
+		// this is default behavior, when disabled every found cycle is reported
+		Cycles() common.Referable[bool]
+
+		// Leaks allows exported API of component (with DeepScan) to mention
+		// types, that consumers of this API can't import. This is default
+		// behavior, when disabled every leaked type is reported
+		Leaks() common.Referable[bool]
 	}
 
 	Vendor interface {
@@ -81,6 +129,14 @@ type (
 		ImportPaths() []models.Glob
 	}
 
+	StdlibPackages interface {
+		// ImportPaths is list of go standard library import paths
+		// example:
+		// 	- net/http
+		// 	- os/**
+		ImportPaths() []models.Glob
+	}
+
 	Component interface {
 		// RelativePaths can contain glob's
 		// example:
@@ -88,15 +144,71 @@ type (
 		// 	- /
 		// 	- tests/**
 		RelativePaths() []models.Glob
+
+		// Priority used when package matched by several components,
+		// component with higher priority will own package (default=0)
+		Priority() common.Referable[int]
+
+		// Exports is list of component packages (can contain glob's), that can be
+		// imported by other components. Nil means that all packages are exported
+		// example:
+		// 	- internal/service/billing
+		Exports() []models.Glob
+	}
+
+	Group interface {
+		// Components is list of Component names in group, can contain
+		// component templates (all instances) and globs of names
+		// example:
+		// 	- module-{name}
+		// 	- module-*
+		Components() []common.Referable[string]
+
+		// Isolated deny imports between group components (each component
+		// can import only PublicAPI packages of other group components)
+		Isolated() common.Referable[bool]
+
+		// PublicAPI is relative path of packages inside each group component,
+		// that can be imported by other group components (empty = nothing)
+		// example:
+		// 	- api
+		PublicAPI() common.Referable[string]
 	}
 
 	DependencyRule interface {
 		// MayDependOn is list of Component names, that can be imported to described component
 		MayDependOn() []common.Referable[string]
 
-		// CanUse is list of Vendor names, that can be imported to described component
+		// CanUse is list of Vendor (or Stdlib) names, that can be imported to described component
 		CanUse() []common.Referable[string]
 
+		// MayNotDependOn is list of Component names, that can`t be imported to described component
+		// deny rules take precedence over MayDependOn, CommonComponents and AnyProjectDeps
+		MayNotDependOn() []common.Referable[string]
+
+		// CannotUse is list of Vendor (or Stdlib) names, that can`t be imported to described component
+		// deny rules take precedence over CanUse, CommonVendors, AnyVendorDeps and global DepOnAnyVendor
+		// (or CommonStdlib and global DepOnAnyStdlib for Stdlib names)
+		CannotUse() []common.Referable[string]
+
+		// Capabilities is list of allowed models.Capability names (network, filesystem, exec, ...)
+		// for described component. Nil means that component capabilities are not restricted
+		Capabilities() []common.Referable[string]
+
+		// ForbidSymbols is list of vendor (or stdlib) package level identifiers, qualified
+		// by import path, that can`t be used in described component
+		// example:
+		// 	- time.Now
+		// 	- fmt.Println
+		ForbidSymbols() []common.Referable[string]
+
+		// AllowSymbols is list of vendor (or stdlib) package level identifiers, qualified
+		// by import path. When package has allowed symbols, only this symbols
+		// of package can be used in described component
+		// example:
+		// 	- github.com/pkg/errors.Wrap
+		AllowSymbols() []common.Referable[string]
+
 		// AnyProjectDeps allow component to import any other local namespace packages
 		AnyProjectDeps() common.Referable[bool]
 
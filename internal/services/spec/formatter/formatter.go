@@ -0,0 +1,481 @@
+package formatter
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/source"
+	"github.com/fe3dback/go-yaml"
+	"github.com/fe3dback/go-yaml/ast"
+	"github.com/fe3dback/go-yaml/token"
+)
+
+type (
+	Formatter struct{}
+
+	// pass is one formatting rule, every pass
+	// work with freshly parsed source code
+	pass func(src *source.Source) error
+
+	// edit is source code change at some position,
+	// edits applied from bottom to top, so positions
+	// of not applied edits are always valid
+	edit struct {
+		line   int
+		column int
+		apply  func(src *source.Source) error
+	}
+)
+
+// sortedSections is top level maps, sorted by keys
+var sortedSections = []string{
+	"vendors",
+	"stdlib",
+	"components",
+	"deps",
+}
+
+func NewFormatter() *Formatter {
+	return &Formatter{}
+}
+
+func (f *Formatter) Format(archFilePath string) (models.Formatting, error) {
+	sourceCode, err := os.ReadFile(archFilePath)
+	if err != nil {
+		return models.Formatting{}, fmt.Errorf("failed read archfile: %w", err)
+	}
+
+	formatted, err := format(sourceCode)
+	if err != nil {
+		return models.Formatting{}, err
+	}
+
+	return models.Formatting{
+		Source:    sourceCode,
+		Formatted: formatted,
+	}, nil
+}
+
+func format(sourceCode []byte) ([]byte, error) {
+	passes := []pass{
+		normalizeQuotes,
+		normalizeLists,
+	}
+
+	for _, section := range sortedSections {
+		section := section
+		passes = append(passes, func(src *source.Source) error {
+			return sortSection(src, section)
+		})
+	}
+
+	formatted := sourceCode
+	for _, apply := range passes {
+		src, err := source.Parse(formatted)
+		if err != nil {
+			return nil, fmt.Errorf("failed parse archfile: %w", err)
+		}
+
+		err = apply(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed format archfile: %w", err)
+		}
+
+		formatted = src.Code()
+	}
+
+	err := assertSameDocument(sourceCode, formatted)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatted, nil
+}
+
+// normalizeQuotes remove quotes from all strings, that not require it,
+// other strings will be double-quoted
+func normalizeQuotes(src *source.Source) error {
+	edits := make([]edit, 0)
+
+	walk(src, func(node ast.Node, _ *ast.MappingValueNode, _ bool) {
+		tkn := node.GetToken()
+		if _, ok := node.(*ast.StringNode); !ok || tkn == nil {
+			return
+		}
+
+		if tkn.Type != token.SingleQuoteType && tkn.Type != token.DoubleQuoteType {
+			return
+		}
+
+		value := tkn.Value
+		raw := value
+
+		if isNeedQuoted(value) {
+			if tkn.Type == token.DoubleQuoteType {
+				return
+			}
+
+			raw = strconv.Quote(value)
+		}
+
+		edits = append(edits, edit{
+			line:   tkn.Position.Line,
+			column: tkn.Position.Column,
+			apply: func(src *source.Source) error {
+				return src.ReplaceRaw(tkn, raw)
+			},
+		})
+	})
+
+	return applyEdits(src, edits)
+}
+
+// normalizeLists will replace:
+// - lists with one element in "in" to string (in: [a] -> in: a)
+// - flow style lists in block context to block style lists
+func normalizeLists(src *source.Source) error {
+	edits := make([]edit, 0)
+
+	walk(src, func(node ast.Node, parent *ast.MappingValueNode, inFlow bool) {
+		seq, ok := node.(*ast.SequenceNode)
+		if !ok || parent == nil || !isScalarList(seq) {
+			return
+		}
+
+		key := parent.Key.GetToken()
+
+		if key.Value == "in" && len(seq.Values) == 1 {
+			edits = append(edits, edit{
+				line:   key.Position.Line,
+				column: key.Position.Column,
+				apply: func(src *source.Source) error {
+					return listToString(src, key, seq)
+				},
+			})
+
+			return
+		}
+
+		if inFlow || !seq.IsFlowStyle || len(seq.Values) == 0 {
+			return
+		}
+
+		edits = append(edits, edit{
+			line:   key.Position.Line,
+			column: key.Position.Column,
+			apply: func(src *source.Source) error {
+				return flowListToBlock(src, key, seq)
+			},
+		})
+	})
+
+	return applyEdits(src, edits)
+}
+
+func listToString(src *source.Source, key *token.Token, seq *ast.SequenceNode) error {
+	item := seq.Values[0].GetToken()
+	raw, err := src.Raw(item)
+	if err != nil {
+		return err
+	}
+
+	if seq.IsFlowStyle {
+		if !isOneLineFlow(seq, seq.Start.Position.Line) {
+			return nil
+		}
+
+		line := src.Line(seq.Start.Position.Line)
+		from := seq.Start.Position.Column - 1
+		to := seq.End.Position.Column
+
+		src.ReplaceLines(seq.Start.Position.Line, seq.Start.Position.Line, line[:from]+raw+line[to:])
+		return nil
+	}
+
+	// block list, item should be defined on next line after key
+	keyLine := src.Line(key.Position.Line)
+	itemLine := src.Line(item.Position.Line)
+
+	if item.Position.Line != key.Position.Line+1 || !strings.HasSuffix(strings.TrimRight(keyLine, " "), ":") {
+		return nil
+	}
+
+	itemFrom := item.Position.Column - 1
+	itemTail := itemLine[itemFrom:]
+
+	src.ReplaceLines(key.Position.Line, item.Position.Line, strings.TrimRight(keyLine, " ")+" "+itemTail)
+	return nil
+}
+
+func flowListToBlock(src *source.Source, key *token.Token, seq *ast.SequenceNode) error {
+	if !isOneLineFlow(seq, key.Position.Line) {
+		return nil
+	}
+
+	indent := strings.Repeat(" ", key.Position.Column-1)
+	items := make([]string, 0, len(seq.Values))
+
+	for _, value := range seq.Values {
+		raw, err := src.Raw(value.GetToken())
+		if err != nil {
+			return err
+		}
+
+		items = append(items, fmt.Sprintf("%s  - %s", indent, raw))
+	}
+
+	line := src.Line(key.Position.Line)
+	head := strings.TrimRight(line[:seq.Start.Position.Column-1], " ")
+	tail := strings.TrimSpace(line[seq.End.Position.Column:])
+
+	if tail != "" {
+		head += " " + tail
+	}
+
+	src.ReplaceLines(key.Position.Line, key.Position.Line, append([]string{head}, items...)...)
+	return nil
+}
+
+// sortSection sort block map entries by keys, entry head comments
+// moved together with entry, empty lines between entries stay on same place
+func sortSection(src *source.Source, section string) error {
+	node, ok := src.RootValue(section)
+	if !ok {
+		return nil
+	}
+
+	mapping, ok := node.Value.(*ast.MappingNode)
+	if !ok || mapping.IsFlowStyle || len(mapping.Values) < 2 {
+		return nil
+	}
+
+	type entry struct {
+		key       string
+		headStart int
+		bodyEnd   int
+	}
+
+	entries := make([]entry, 0, len(mapping.Values))
+	for _, value := range mapping.Values {
+		keyToken := value.Key.GetToken()
+		entries = append(entries, entry{
+			key:       keyToken.Value,
+			headStart: headCommentStart(src, keyToken),
+		})
+	}
+
+	for ind := range entries {
+		keyToken := mapping.Values[ind].Key.GetToken()
+		limit := src.LinesCount()
+
+		if ind+1 < len(entries) {
+			limit = entries[ind+1].headStart - 1
+		}
+
+		entries[ind].bodyEnd = bodyEnd(src, keyToken, limit)
+	}
+
+	sorted := make([]entry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].key < sorted[j].key
+	})
+
+	if reflect.DeepEqual(sorted, entries) {
+		return nil
+	}
+
+	lines := make([]string, 0)
+	for ind, sortedEntry := range sorted {
+		for line := sortedEntry.headStart; line <= sortedEntry.bodyEnd; line++ {
+			lines = append(lines, src.Line(line))
+		}
+
+		// gap between entries stay on same place
+		if ind+1 < len(entries) {
+			for line := entries[ind].bodyEnd + 1; line < entries[ind+1].headStart; line++ {
+				lines = append(lines, src.Line(line))
+			}
+		}
+	}
+
+	src.ReplaceLines(entries[0].headStart, entries[len(entries)-1].bodyEnd, lines...)
+	return nil
+}
+
+// headCommentStart find first line of comments, defined right before key
+func headCommentStart(src *source.Source, key *token.Token) int {
+	start := key.Position.Line
+	indent := key.Position.Column - 1
+
+	for line := start - 1; line >= 1; line-- {
+		code := src.Line(line)
+		if !isComment(code) || lineIndent(code) != indent {
+			break
+		}
+
+		start = line
+	}
+
+	return start
+}
+
+// bodyEnd find last line of entry value, but not after limit line
+func bodyEnd(src *source.Source, key *token.Token, limit int) int {
+	end := key.Position.Line
+	indent := key.Position.Column - 1
+
+	for line := end + 1; line <= limit; line++ {
+		code := src.Line(line)
+		if strings.TrimSpace(code) == "" {
+			continue
+		}
+
+		if lineIndent(code) <= indent {
+			break
+		}
+
+		end = line
+	}
+
+	return end
+}
+
+func applyEdits(src *source.Source, edits []edit) error {
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].line != edits[j].line {
+			return edits[i].line > edits[j].line
+		}
+
+		return edits[i].column > edits[j].column
+	})
+
+	for _, change := range edits {
+		err := change.apply(src)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walk call fn for all document nodes
+func walk(src *source.Source, fn func(node ast.Node, parent *ast.MappingValueNode, inFlow bool)) {
+	var visit func(node ast.Node, parent *ast.MappingValueNode, inFlow bool)
+	visit = func(node ast.Node, parent *ast.MappingValueNode, inFlow bool) {
+		if node == nil {
+			return
+		}
+
+		fn(node, parent, inFlow)
+
+		switch typed := node.(type) {
+		case *ast.MappingNode:
+			for _, value := range typed.Values {
+				visit(value, parent, inFlow || typed.IsFlowStyle)
+			}
+		case *ast.MappingValueNode:
+			visit(typed.Key, typed, inFlow)
+			visit(typed.Value, typed, inFlow)
+		case *ast.SequenceNode:
+			for _, value := range typed.Values {
+				visit(value, nil, inFlow || typed.IsFlowStyle)
+			}
+		}
+	}
+
+	for _, node := range src.Root() {
+		visit(node, nil, false)
+	}
+}
+
+// isOneLineFlow check that flow list fully defined on one line
+func isOneLineFlow(seq *ast.SequenceNode, line int) bool {
+	if seq.Start == nil || seq.End == nil {
+		return false
+	}
+
+	return seq.Start.Position.Line == line && seq.End.Position.Line == line
+}
+
+func isScalarList(seq *ast.SequenceNode) bool {
+	for _, value := range seq.Values {
+		if _, ok := value.(ast.ScalarNode); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNeedQuoted(value string) bool {
+	if token.IsNeedQuoted(value) {
+		return true
+	}
+
+	if strings.TrimSpace(value) != value {
+		return true
+	}
+
+	if strings.ContainsAny(value, ",[]{}\n\t\"'") {
+		return true
+	}
+
+	return strings.HasPrefix(value, "-") || strings.HasPrefix(value, "?")
+}
+
+func isComment(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "#")
+}
+
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// assertSameDocument check that formatting not change any document values
+func assertSameDocument(source []byte, formatted []byte) error {
+	var sourceDoc, formattedDoc interface{}
+
+	err := yaml.Unmarshal(source, &sourceDoc)
+	if err != nil {
+		return fmt.Errorf("failed parse archfile: %w", err)
+	}
+
+	err = yaml.Unmarshal(formatted, &formattedDoc)
+	if err != nil {
+		return fmt.Errorf("formatted archfile is not valid yaml (this is bug, please report it): %w", err)
+	}
+
+	if !reflect.DeepEqual(normalizeDocument(sourceDoc), normalizeDocument(formattedDoc)) {
+		return fmt.Errorf("formatted archfile not equal to source archfile (this is bug, please report it)")
+	}
+
+	return nil
+}
+
+// normalizeDocument replace all lists with one element in "in" to string,
+// because this is equal forms for archfile
+func normalizeDocument(node interface{}) interface{} {
+	switch typed := node.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			if list, ok := value.([]interface{}); ok && key == "in" && len(list) == 1 {
+				value = list[0]
+			}
+
+			typed[key] = normalizeDocument(value)
+		}
+	case []interface{}:
+		for ind, value := range typed {
+			typed[ind] = normalizeDocument(value)
+		}
+	}
+
+	return node
+}
@@ -0,0 +1,62 @@
+package formatter
+
+import (
+	"testing"
+)
+
+func Test_format(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "already formatted",
+			source: "version: 3\ncomponents:\n  a: { in: a }\n  b: { in: b }\n",
+			want:   "version: 3\ncomponents:\n  a: { in: a }\n  b: { in: b }\n",
+		},
+		{
+			name:   "quotes",
+			source: "version: 3\nworkdir: 'internal'\nexcludeFiles:\n  - \"^.*_test\\\\.go$\"\n  - 'a: b'\n",
+			want:   "version: 3\nworkdir: internal\nexcludeFiles:\n  - \"^.*_test\\\\.go$\"\n  - \"a: b\"\n",
+		},
+		{
+			name:   "in with one element",
+			source: "components:\n  a: { in: [ a ] }\n  b:\n    in:\n      - b # comment\n",
+			want:   "components:\n  a: { in: a }\n  b:\n    in: b # comment\n",
+		},
+		{
+			name:   "flow list to block",
+			source: "deps:\n  a:\n    mayDependOn: [ b, c ] # comment\n",
+			want:   "deps:\n  a:\n    mayDependOn: # comment\n      - b\n      - c\n",
+		},
+		{
+			name:   "flow list in flow map not changed",
+			source: "vendors:\n  a: { in: [ a, b ] }\n",
+			want:   "vendors:\n  a: { in: [ a, b ] }\n",
+		},
+		{
+			name:   "sort with comments and gaps",
+			source: "deps:\n  # c head\n  c:\n    anyVendorDeps: true\n\n  a:\n    mayDependOn:\n      - c\n      # inner\n\n  b:\n    anyVendorDeps: true\n",
+			want:   "deps:\n  a:\n    mayDependOn:\n      - c\n      # inner\n\n  b:\n    anyVendorDeps: true\n\n  # c head\n  c:\n    anyVendorDeps: true\n",
+		},
+		{
+			name:    "root is not map",
+			source:  "- version: 3\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := format([]byte(tt.source))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("format() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("format() got = %q, want %q", string(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package inspector
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	// Inspector compare archfile with real project code
+	// and find archfile entries, that not affect anything
+	Inspector struct {
+		documentDecoder      documentDecoder
+		projectFilesResolver projectFilesResolver
+	}
+
+	// usage is real project imports, grouped by components
+	usage struct {
+		componentFiles    map[string]int
+		componentDeps     map[string]map[string]bool
+		componentPackages map[string]map[string]struct{} // component -> vendor and stdlib imports
+		packages          map[string]struct{}            // all vendor and stdlib imports
+	}
+)
+
+func NewInspector(
+	documentDecoder documentDecoder,
+	projectFilesResolver projectFilesResolver,
+) *Inspector {
+	return &Inspector{
+		documentDecoder:      documentDecoder,
+		projectFilesResolver: projectFilesResolver,
+	}
+}
+
+func (i *Inspector) Inspect(ctx context.Context, archSpec arch.Spec, archFilePath string) ([]arch.Notice, error) {
+	document, _, err := i.documentDecoder.Decode(archFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed decode archfile: %w", err)
+	}
+
+	projectFiles, err := i.projectFilesResolver.ProjectFiles(ctx, archSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project files: %w", err)
+	}
+
+	used := i.collectUsage(archSpec, document, projectFiles)
+
+	notices := make([]arch.Notice, 0)
+	notices = append(notices, i.inspectComponents(document, used)...)
+	notices = append(notices, i.inspectDeps(document, used)...)
+	notices = append(notices, i.inspectVendors(document, used)...)
+	notices = append(notices, i.inspectExcludes(archSpec, document)...)
+
+	return notices, nil
+}
+
+func (i *Inspector) collectUsage(archSpec arch.Spec, document spec.Document, projectFiles []models.FileHold) usage {
+	used := usage{
+		componentFiles:    map[string]int{},
+		componentDeps:     map[string]map[string]bool{},
+		componentPackages: map[string]map[string]struct{}{},
+		packages:          map[string]struct{}{},
+	}
+
+	// package directory -> component
+	packageComponents := models.PackageComponents(projectFiles)
+	for _, hold := range projectFiles {
+		if hold.ComponentID == nil {
+			continue
+		}
+
+		used.componentFiles[documentName(document, *hold.ComponentID)]++
+	}
+
+	for _, hold := range projectFiles {
+		for _, resolvedImport := range hold.File.Imports {
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				used.packages[resolvedImport.Name] = struct{}{}
+			}
+
+			if hold.ComponentID == nil {
+				continue
+			}
+
+			from := documentName(document, *hold.ComponentID)
+			if resolvedImport.ImportType != models.ImportTypeProject {
+				if _, ok := used.componentPackages[from]; !ok {
+					used.componentPackages[from] = map[string]struct{}{}
+				}
+
+				used.componentPackages[from][resolvedImport.Name] = struct{}{}
+				continue
+			}
+
+			importDirectory := filepath.Join(
+				archSpec.RootDirectory.Value,
+				strings.TrimPrefix(resolvedImport.Name, archSpec.ModuleName.Value),
+			)
+
+			to, ok := packageComponents[importDirectory]
+			if !ok {
+				continue
+			}
+
+			to = documentName(document, to)
+
+			if _, ok := used.componentDeps[from]; !ok {
+				used.componentDeps[from] = map[string]bool{}
+			}
+
+			used.componentDeps[from][to] = true
+		}
+	}
+
+	return used
+}
+
+func (i *Inspector) inspectComponents(document spec.Document, used usage) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for name, component := range document.Components() {
+		if used.componentFiles[name] > 0 {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("component '%s' not match any go file ('in' paths are empty, excluded or matched by other components)", name),
+			Ref:    component.Reference,
+		})
+	}
+
+	return notices
+}
+
+func (i *Inspector) inspectDeps(document spec.Document, used usage) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	commonComponents := referableSet(document.CommonComponents())
+	commonVendors := referableSet(document.CommonVendors())
+
+	for name, rule := range document.Dependencies() {
+		components := ruleComponents(document, name)
+		files := 0
+		for _, component := range components {
+			files += used.componentFiles[component]
+		}
+
+		for _, dependency := range rule.Value.MayDependOn() {
+			if _, ok := commonComponents[dependency.Value]; ok {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("component '%s' in '%s' mayDependOn is already allowed by commonComponents", dependency.Value, name),
+					Ref:    dependency.Reference,
+				})
+
+				continue
+			}
+
+			if files == 0 || dependsOn(used, components, dependency.Value) {
+				continue
+			}
+
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' never depend on '%s', 'mayDependOn' rule is not used", name, dependency.Value),
+				Ref:    dependency.Reference,
+			})
+		}
+
+		for _, packageName := range rule.Value.CanUse() {
+			if _, ok := commonVendors[packageName.Value]; ok {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("vendor '%s' in '%s' canUse is already allowed by commonVendors", packageName.Value, name),
+					Ref:    packageName.Reference,
+				})
+
+				continue
+			}
+
+			globs, known := packageGlobs(document, packageName.Value)
+			if !known || files == 0 || anyComponentMatch(globs, components, used) {
+				continue
+			}
+
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' never import '%s', 'canUse' rule is not used", name, packageName.Value),
+				Ref:    packageName.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (i *Inspector) inspectVendors(document spec.Document, used usage) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for name, vendor := range document.Vendors() {
+		if anyMatch(vendor.Value.ImportPaths(), used.packages) {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("vendor '%s' is never imported in project", name),
+			Ref:    vendor.Reference,
+		})
+	}
+
+	return notices
+}
+
+func (i *Inspector) inspectExcludes(archSpec arch.Spec, document spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	resolved := make(map[common.Reference]bool)
+	for _, exclude := range archSpec.Exclude {
+		resolved[exclude.Reference] = true
+	}
+
+	for _, exclude := range document.ExcludedDirectories() {
+		if resolved[exclude.Reference] {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("excluded path '%s' not exist", exclude.Value),
+			Ref:    exclude.Reference,
+		})
+	}
+
+	return notices
+}
+
+// ruleComponents return archfile names of all components, affected by
+// deps rule. Rule key can be component name, template or glob of names ("module-*")
+func ruleComponents(document spec.Document, ruleName string) []string {
+	if !spec.IsRuleGlob(ruleName) {
+		return []string{ruleName}
+	}
+
+	components := make([]string, 0)
+	for name := range document.Components() {
+		if spec.RuleGlobMatch(ruleName, name) {
+			components = append(components, name)
+		}
+	}
+
+	return components
+}
+
+// dependsOn check if any of components really import dependency
+// (component name, template, glob of names or "self")
+func dependsOn(used usage, components []string, dependency string) bool {
+	for _, component := range components {
+		pattern := dependency
+		if pattern == spec.SelfComponent {
+			pattern = component
+		}
+
+		for to := range used.componentDeps[component] {
+			if spec.MatchComponentName(pattern, to) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func anyComponentMatch(globs []models.Glob, components []string, used usage) bool {
+	for _, component := range components {
+		if anyMatch(globs, used.componentPackages[component]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func packageGlobs(document spec.Document, name string) ([]models.Glob, bool) {
+	if vendor, ok := document.Vendors()[name]; ok {
+		return vendor.Value.ImportPaths(), true
+	}
+
+	if stdlib, ok := document.Stdlib()[name]; ok {
+		return stdlib.Value.ImportPaths(), true
+	}
+
+	return nil, false
+}
+
+func anyMatch(globs []models.Glob, importPaths map[string]struct{}) bool {
+	for importPath := range importPaths {
+		if matched, _ := models.MatchGlobs(globs, importPath); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// documentName return name of component in archfile,
+// instances of component template is reported as template
+func documentName(document spec.Document, name string) string {
+	if _, ok := document.Components()[name]; ok {
+		return name
+	}
+
+	if template, _, ok := spec.FindTemplate(document, name); ok {
+		return template
+	}
+
+	return name
+}
+
+func referableSet(list []common.Referable[string]) map[string]struct{} {
+	set := make(map[string]struct{}, len(list))
+	for _, item := range list {
+		set[item.Value] = struct{}{}
+	}
+
+	return set
+}
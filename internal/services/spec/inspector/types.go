@@ -0,0 +1,19 @@
+package inspector
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	documentDecoder interface {
+		Decode(archFile string) (spec.Document, []arch.Notice, error)
+	}
+
+	projectFilesResolver interface {
+		ProjectFiles(ctx context.Context, spec arch.Spec) ([]models.FileHold, error)
+	}
+)
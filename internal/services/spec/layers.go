@@ -0,0 +1,19 @@
+package spec
+
+// LayerMatch check if component belongs to layer. Layer is
+// name of group (all group components) or component name pattern
+// (component name, component template or glob of names)
+func LayerMatch(document Document, layer string, name ComponentName) bool {
+	group, ok := document.Groups()[layer]
+	if !ok {
+		return MatchComponentName(layer, name)
+	}
+
+	for _, pattern := range group.Value.Components() {
+		if MatchComponentName(pattern.Value, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,172 @@
+package migrator
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/source"
+	"github.com/fe3dback/go-yaml/ast"
+)
+
+type (
+	Migrator struct {
+		documentDecoder documentDecoder
+	}
+
+	// step upgrade archfile from version "from" to "from+1"
+	step struct {
+		from        int
+		description string
+		apply       func(src *source.Source) error
+	}
+)
+
+var steps = []step{
+	{
+		from:        1,
+		description: "nothing to change (v2 only add new options)",
+		apply:       func(_ *source.Source) error { return nil },
+	},
+	{
+		from:        2,
+		description: "'allow.deepScan' set to false (deepScan is enabled by default since v3)",
+		apply:       disableDeepScan,
+	},
+	{
+		from:        3,
+		description: "nothing to change (v4 only add new options)",
+		apply:       func(_ *source.Source) error { return nil },
+	},
+}
+
+func NewMigrator(documentDecoder documentDecoder) *Migrator {
+	return &Migrator{
+		documentDecoder: documentDecoder,
+	}
+}
+
+func (m *Migrator) Migrate(archFilePath string) (models.Migration, error) {
+	document, notices, err := m.documentDecoder.Decode(archFilePath)
+	if err != nil {
+		return models.Migration{}, fmt.Errorf("failed decode archfile: %w", err)
+	}
+
+	if len(notices) > 0 {
+		return models.Migration{}, fmt.Errorf("archfile has %d schema errors (first: %w), run 'self-inspect' for details",
+			len(notices),
+			notices[0].Notice,
+		)
+	}
+
+	sourceCode, err := os.ReadFile(archFilePath)
+	if err != nil {
+		return models.Migration{}, fmt.Errorf("failed read archfile: %w", err)
+	}
+
+	migration := models.Migration{
+		FromVersion: document.Version().Value,
+		ToVersion:   document.Version().Value,
+		Changes:     []string{},
+		Source:      sourceCode,
+		Migrated:    sourceCode,
+	}
+
+	for _, upgrade := range steps {
+		if upgrade.from != migration.ToVersion {
+			continue
+		}
+
+		migrated, err := applyStep(migration.Migrated, upgrade)
+		if err != nil {
+			return models.Migration{}, fmt.Errorf("failed migrate v%d -> v%d: %w", upgrade.from, upgrade.from+1, err)
+		}
+
+		migration.Migrated = migrated
+		migration.ToVersion = upgrade.from + 1
+		migration.Changes = append(migration.Changes, fmt.Sprintf("v%d -> v%d: %s",
+			upgrade.from,
+			upgrade.from+1,
+			upgrade.description,
+		))
+	}
+
+	return migration, nil
+}
+
+func applyStep(sourceCode []byte, upgrade step) ([]byte, error) {
+	src, err := source.Parse(sourceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = upgrade.apply(src)
+	if err != nil {
+		return nil, err
+	}
+
+	// code is changed, so need to parse it again
+	// for actual nodes positions
+	src, err = source.Parse(src.Code())
+	if err != nil {
+		return nil, fmt.Errorf("migrated code is not valid yaml: %w", err)
+	}
+
+	err = setVersion(src, upgrade.from+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return src.Code(), nil
+}
+
+func setVersion(src *source.Source, version int) error {
+	node, ok := src.RootValue("version")
+	if !ok {
+		return fmt.Errorf("not found 'version' in archfile")
+	}
+
+	return src.Replace(node.Value.GetToken(), fmt.Sprintf("%d", version))
+}
+
+func disableDeepScan(src *source.Source) error {
+	const option = "deepScan: false"
+
+	node, ok := src.RootValue("allow")
+	if !ok {
+		versionNode, _ := src.RootValue("version")
+		line := versionNode.Key.GetToken().Position.Line
+
+		src.InsertLines(line, "allow:", fmt.Sprintf("  %s", option))
+		return nil
+	}
+
+	switch value := node.Value.(type) {
+	case *ast.MappingNode:
+		if value.IsFlowStyle {
+			if len(value.Values) == 0 {
+				return src.InsertAfter(value.Start, fmt.Sprintf(" %s ", option))
+			}
+
+			return src.InsertAfter(value.Start, fmt.Sprintf(" %s,", option))
+		}
+
+		if len(value.Values) > 0 {
+			src.InsertLines(node.Key.GetToken().Position.Line, keyIndent(value.Values[0])+option)
+			return nil
+		}
+	case *ast.MappingValueNode:
+		src.InsertLines(node.Key.GetToken().Position.Line, keyIndent(value)+option)
+		return nil
+	case *ast.NullNode:
+		src.InsertLines(node.Key.GetToken().Position.Line, fmt.Sprintf("  %s", option))
+		return nil
+	}
+
+	return fmt.Errorf("unexpected 'allow' value type %T", node.Value)
+}
+
+func keyIndent(node *ast.MappingValueNode) string {
+	return strings.Repeat(" ", node.Key.GetToken().Position.Column-1)
+}
@@ -0,0 +1,74 @@
+package migrator
+
+import (
+	"testing"
+
+	"github.com/fe3dback/go-arch-lint/internal/services/common/yaml/source"
+)
+
+func Test_applyStep(t *testing.T) {
+	deepScanStep := step{from: 2, apply: disableDeepScan}
+
+	tests := []struct {
+		name    string
+		step    step
+		source  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "version only",
+			step:   step{from: 3, apply: func(_ *source.Source) error { return nil }},
+			source: "version: 3 # comment\nworkdir: internal\n",
+			want:   "version: 4 # comment\nworkdir: internal\n",
+		},
+		{
+			name:   "allow not exist",
+			step:   deepScanStep,
+			source: "# head\nversion: 2\ncomponents: {}\n",
+			want:   "# head\nversion: 3\nallow:\n  deepScan: false\ncomponents: {}\n",
+		},
+		{
+			name:   "allow block",
+			step:   deepScanStep,
+			source: "version: 2\nallow:\n    depOnAnyVendor: true\n",
+			want:   "version: 3\nallow:\n    deepScan: false\n    depOnAnyVendor: true\n",
+		},
+		{
+			name:   "allow flow",
+			step:   deepScanStep,
+			source: "version: 2\nallow: { depOnAnyVendor: true }\n",
+			want:   "version: 3\nallow: { deepScan: false, depOnAnyVendor: true }\n",
+		},
+		{
+			name:   "allow flow empty",
+			step:   deepScanStep,
+			source: "version: 2\nallow: {}\n",
+			want:   "version: 3\nallow: { deepScan: false }\n",
+		},
+		{
+			name:   "allow null",
+			step:   deepScanStep,
+			source: "version: 2\nallow:\ndeps: {}\n",
+			want:   "version: 3\nallow:\n  deepScan: false\ndeps: {}\n",
+		},
+		{
+			name:    "not map",
+			step:    deepScanStep,
+			source:  "- version: 2\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyStep([]byte(tt.source), tt.step)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyStep() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("applyStep() got = %q, want %q", string(got), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package migrator
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type (
+	documentDecoder interface {
+		Decode(archFile string) (spec.Document, []arch.Notice, error)
+	}
+)
@@ -0,0 +1,127 @@
+package spec
+
+import (
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+)
+
+// TemplateParam is placeholder in component name and component
+// "in" paths. Component with this placeholder in name is template,
+// it will be expanded into one component per directory, matched
+// by placeholder path segment:
+//
+//	module-{name}: { in: internal/modules/{name}/** }
+//
+// will be expanded into "module-billing", "module-users", etc...
+const TemplateParam = "{name}"
+
+// IsTemplate is true, when component name is template
+func IsTemplate(name ComponentName) bool {
+	return strings.Contains(name, TemplateParam)
+}
+
+// ExpandTemplate replace template placeholder with value
+func ExpandTemplate(template string, value string) string {
+	return strings.ReplaceAll(template, TemplateParam, value)
+}
+
+// ExpandTemplateGlobs replace template placeholder in all globs with value
+func ExpandTemplateGlobs(globs []models.Glob, value string) []models.Glob {
+	expanded := make([]models.Glob, 0, len(globs))
+	for _, glob := range globs {
+		expanded = append(expanded, models.Glob(ExpandTemplate(string(glob), value)))
+	}
+
+	return expanded
+}
+
+// TemplateValue return placeholder value, when name is
+// instance of template ("module-billing" is instance of
+// template "module-{name}" with value "billing")
+func TemplateValue(template string, name string) (string, bool) {
+	prefix, suffix, found := strings.Cut(template, TemplateParam)
+	if !found || strings.Contains(suffix, TemplateParam) {
+		return "", false
+	}
+
+	if len(name) <= len(prefix)+len(suffix) {
+		return "", false
+	}
+
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return "", false
+	}
+
+	// globs of names (module-*) are not instances of template
+	value := name[len(prefix) : len(name)-len(suffix)]
+	if strings.ContainsAny(value, "/*") {
+		return "", false
+	}
+
+	return value, true
+}
+
+// FindTemplate return template of component instance name. When name
+// matched by several templates, most specific (longest) template is used
+func FindTemplate(document Document, name ComponentName) (template ComponentName, value string, ok bool) {
+	for componentName := range document.Components() {
+		if !IsTemplate(componentName) {
+			continue
+		}
+
+		componentValue, matched := TemplateValue(componentName, name)
+		if !matched {
+			continue
+		}
+
+		if ok && (len(componentName) < len(template) || (len(componentName) == len(template) && componentName > template)) {
+			continue
+		}
+
+		template, value, ok = componentName, componentValue, true
+	}
+
+	return template, value, ok
+}
+
+// SelfComponent is placeholder in deps component lists
+// (mayDependOn, mayNotDependOn), replaced by name of
+// component, that rule is applied to. Useful with rules
+// keyed by component name glob:
+//
+//	module-*: { mayDependOn: [self, shared] }
+const SelfComponent = "self"
+
+// IsRuleGlob is true, when deps key is glob of component names
+// (module-*), such rule is applied to all matched components
+func IsRuleGlob(name ComponentName) bool {
+	return strings.Contains(name, "*")
+}
+
+// RuleGlobMatch check if component name matched by deps key glob.
+// Template components matched by any of their instances
+func RuleGlobMatch(glob string, name ComponentName) bool {
+	matched, err := models.Glob(glob).Match(ExpandTemplate(name, "x"))
+	return err == nil && matched
+}
+
+// MatchComponentName check if component name matched by pattern from
+// components list. Pattern can be component name, component
+// template (matches all instances) or glob of names
+func MatchComponentName(pattern string, name ComponentName) bool {
+	if pattern == name {
+		return true
+	}
+
+	if IsTemplate(pattern) {
+		_, ok := TemplateValue(pattern, name)
+		return ok
+	}
+
+	if IsRuleGlob(pattern) {
+		return RuleGlobMatch(pattern, name)
+	}
+
+	return false
+}
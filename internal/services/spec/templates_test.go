@@ -0,0 +1,102 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  string
+		instance  string
+		wantValue string
+		wantOk    bool
+	}{
+		{
+			name:      "prefix",
+			template:  "module-{name}",
+			instance:  "module-billing",
+			wantValue: "billing",
+			wantOk:    true,
+		},
+		{
+			name:      "prefix and suffix",
+			template:  "module-{name}-api",
+			instance:  "module-users-api",
+			wantValue: "users",
+			wantOk:    true,
+		},
+		{
+			name:     "empty value",
+			template: "module-{name}",
+			instance: "module-",
+			wantOk:   false,
+		},
+		{
+			name:     "glob of names",
+			template: "module-{name}",
+			instance: "module-*",
+			wantOk:   false,
+		},
+		{
+			name:     "other prefix",
+			template: "module-{name}",
+			instance: "api-billing",
+			wantOk:   false,
+		},
+		{
+			name:     "not template",
+			template: "module",
+			instance: "module",
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := TemplateValue(tt.template, tt.instance)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+func TestRuleGlobMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		glob      string
+		component string
+		want      bool
+	}{
+		{
+			name:      "prefix",
+			glob:      "module-*",
+			component: "module-billing",
+			want:      true,
+		},
+		{
+			name:      "template",
+			glob:      "module-*",
+			component: "module-{name}",
+			want:      true,
+		},
+		{
+			name:      "other prefix",
+			glob:      "module-*",
+			component: "api-billing",
+			want:      false,
+		},
+		{
+			name:      "empty value",
+			glob:      "module-*",
+			component: "module-",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RuleGlobMatch(tt.glob, tt.component))
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -26,18 +28,58 @@ func newUtils(
 }
 
 func (u *utils) assertGlobPathValid(localGlobPath string) error {
+	resolved, err := u.resolveGlobPath(localGlobPath)
+	if err != nil {
+		return err
+	}
+
+	return u.assertDirectoriesValid(resolved...)
+}
+
+func (u *utils) resolveGlobPath(localGlobPath string) ([]string, error) {
 	rootDir := filepath.Dir(u.document.Version().Reference.File)
 	absPath := filepath.Clean(fmt.Sprintf("%s/%s", rootDir, localGlobPath))
 	resolved, err := u.pathResolver.Resolve(absPath)
 	if err != nil {
-		return fmt.Errorf("failed to resolv path: %w", err)
+		return nil, fmt.Errorf("failed to resolv path: %w", err)
 	}
 
 	if len(resolved) == 0 {
-		return fmt.Errorf("not found directories for '%s' in '%s'", localGlobPath, absPath)
+		if models.Glob(localGlobPath).IsFile() {
+			return nil, fmt.Errorf("not found files for '%s' in '%s'", localGlobPath, absPath)
+		}
+
+		return nil, fmt.Errorf("not found directories for '%s' in '%s'", localGlobPath, absPath)
 	}
 
-	return u.assertDirectoriesValid(resolved...)
+	return resolved, nil
+}
+
+// assertExclusionMatchGlobs check that import path exclusion glob
+// can exclude something from other (positive) globs of list
+func (u *utils) assertExclusionMatchGlobs(exclusion models.Glob, globs []models.Glob) error {
+	for _, glob := range globs {
+		if glob.IsExclusion() {
+			continue
+		}
+
+		if glob.Overlaps(exclusion.Pattern()) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("exclusion '%s' does not match any of import paths '%s'", exclusion, joinGlobs(globs))
+}
+
+func joinGlobs(globs []models.Glob) string {
+	positive := make([]string, 0, len(globs))
+	for _, glob := range globs {
+		if !glob.IsExclusion() {
+			positive = append(positive, string(glob))
+		}
+	}
+
+	return strings.Join(positive, ", ")
 }
 
 func (u *utils) assertDirectoriesValid(paths ...string) error {
@@ -57,9 +99,27 @@ func (u *utils) assertKnownComponent(name string) error {
 		}
 	}
 
+	if _, _, ok := spec.FindTemplate(u.document, name); ok {
+		return nil
+	}
+
 	return fmt.Errorf("unknown component '%s'", name)
 }
 
+func (u *utils) assertComponentGlob(glob string) error {
+	if _, err := models.Glob(glob).Match(""); err != nil {
+		return fmt.Errorf("invalid component glob '%s': %w", glob, err)
+	}
+
+	for knownName := range u.document.Components() {
+		if spec.RuleGlobMatch(glob, knownName) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("component glob '%s' does not match any component", glob)
+}
+
 func (u *utils) assertKnownVendor(name string) error {
 	for knownName := range u.document.Vendors() {
 		if name == knownName {
@@ -69,3 +129,23 @@ func (u *utils) assertKnownVendor(name string) error {
 
 	return fmt.Errorf("unknown vendor '%s'", name)
 }
+
+func (u *utils) assertKnownStdlib(name string) error {
+	for knownName := range u.document.Stdlib() {
+		if name == knownName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown stdlib '%s'", name)
+}
+
+// assertKnownVendorOrStdlib used for deps rules, where
+// vendor and stdlib names share same lists (canUse, cannotUse)
+func (u *utils) assertKnownVendorOrStdlib(name string) error {
+	if err := u.assertKnownStdlib(name); err == nil {
+		return nil
+	}
+
+	return u.assertKnownVendor(name)
+}
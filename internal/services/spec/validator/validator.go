@@ -23,12 +23,18 @@ func (v *Validator) Validate(doc spec.Document) []arch.Notice {
 	utils := newUtils(v.pathResolver, doc)
 	validators := []validator{
 		newValidatorCommonComponents(utils),
+		newValidatorCommonStdlib(utils),
 		newValidatorCommonVendors(utils),
 		newValidatorComponents(utils),
 		newValidatorDeps(utils),
+		newValidatorDepsCapabilities(),
 		newValidatorDepsComponents(utils),
+		newValidatorDepsSymbols(),
 		newValidatorDepsVendors(utils),
 		newValidatorExcludeFiles(),
+		newValidatorGroups(utils),
+		newValidatorLayers(utils),
+		newValidatorStdlib(utils),
 		newValidatorVendors(utils),
 		newValidatorVersion(),
 		newValidatorWorkDir(utils),
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorCommonStdlib struct {
+	utils *utils
+}
+
+func newValidatorCommonStdlib(
+	utils *utils,
+) *validatorCommonStdlib {
+	return &validatorCommonStdlib{
+		utils: utils,
+	}
+}
+
+func (v *validatorCommonStdlib) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for _, stdlibName := range doc.CommonStdlib() {
+		if err := v.utils.assertKnownStdlib(stdlibName.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    stdlibName.Reference,
+			})
+		}
+	}
+
+	return notices
+}
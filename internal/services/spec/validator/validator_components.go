@@ -3,8 +3,12 @@ package validator
 import (
 	"fmt"
 	"path"
+	"path/filepath"
+	"strings"
 
+	"github.com/fe3dback/go-arch-lint/internal/models"
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -30,21 +34,184 @@ func (v *validatorComponents) Validate(doc spec.Document) []arch.Notice {
 		})
 	}
 
-	for _, component := range doc.Components() {
-		for _, componentIn := range component.Value.RelativePaths() {
-			localPath := path.Clean(fmt.Sprintf("%s/%s",
-				doc.WorkingDirectory().Value,
-				string(componentIn),
-			))
+	for name, component := range doc.Components() {
+		if err := v.validateTemplate(name, component.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    component.Reference,
+			})
 
-			if err := v.utils.assertGlobPathValid(localPath); err != nil {
-				notices = append(notices, arch.Notice{
-					Notice: err,
-					Ref:    component.Reference,
-				})
+			continue
+		}
+
+		notices = append(notices, v.validateComponentIn(doc, component)...)
+		notices = append(notices, v.validateComponentExports(doc, component)...)
+	}
+
+	return notices
+}
+
+func (v *validatorComponents) validateComponentIn(doc spec.Document, component common.Referable[spec.Component]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	included := make(map[string]struct{})
+	exclusions := make([]models.Glob, 0)
+
+	// template paths validated with any placeholder value
+	for _, componentIn := range spec.ExpandTemplateGlobs(component.Value.RelativePaths(), "*") {
+		if componentIn.IsExclusion() {
+			exclusions = append(exclusions, componentIn)
+			continue
+		}
+
+		localPath := v.localPath(doc, componentIn)
+		if err := v.utils.assertGlobPathValid(localPath); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    component.Reference,
+			})
+			continue
+		}
+
+		resolved, _ := v.utils.resolveGlobPath(localPath)
+		for _, resolvedPath := range resolved {
+			included[filepath.Clean(resolvedPath)] = struct{}{}
+		}
+	}
+
+	if len(exclusions) > 0 && len(exclusions) == len(component.Value.RelativePaths()) {
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("component 'in' should contain at least one path, that is not exclusion"),
+			Ref:    component.Reference,
+		})
+
+		return notices
+	}
+
+	for _, exclusion := range exclusions {
+		resolved, err := v.utils.resolveGlobPath(v.localPath(doc, exclusion.Pattern()))
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("exclusion '%s' does not match anything: %w", exclusion, err),
+				Ref:    component.Reference,
+			})
+			continue
+		}
+
+		if !anyIncluded(resolved, included) {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("exclusion '%s' does not match any directory of component", exclusion),
+				Ref:    component.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorComponents) validateComponentExports(doc spec.Document, component common.Referable[spec.Component]) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	if component.Value.Exports() == nil {
+		return notices
+	}
+
+	included := make(map[string]struct{})
+	for _, componentIn := range spec.ExpandTemplateGlobs(component.Value.RelativePaths(), "*") {
+		if componentIn.IsExclusion() {
+			continue
+		}
+
+		resolved, _ := v.utils.resolveGlobPath(v.localPath(doc, componentIn))
+		for _, resolvedPath := range resolved {
+			included[filepath.Clean(resolvedPath)] = struct{}{}
+		}
+	}
+
+	for _, export := range spec.ExpandTemplateGlobs(component.Value.Exports(), "*") {
+		if export.IsExclusion() {
+			continue
+		}
+
+		resolved, err := v.utils.resolveGlobPath(v.localPath(doc, export))
+		if err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("export '%s' does not match anything: %w", export, err),
+				Ref:    component.Reference,
+			})
+			continue
+		}
+
+		for _, resolvedPath := range resolved {
+			if _, ok := included[filepath.Clean(resolvedPath)]; ok {
+				continue
 			}
+
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("export '%s' match '%s', that is not package of component", export, resolvedPath),
+				Ref:    component.Reference,
+			})
+			break
 		}
 	}
 
 	return notices
 }
+
+func (v *validatorComponents) validateTemplate(name string, component spec.Component) error {
+	isTemplate := spec.IsTemplate(name)
+	if strings.Count(name, spec.TemplateParam) > 1 {
+		return fmt.Errorf("component template name should contain only one '%s'", spec.TemplateParam)
+	}
+
+	paths := make([]models.Glob, 0, len(component.RelativePaths())+len(component.Exports()))
+	paths = append(paths, component.RelativePaths()...)
+	paths = append(paths, component.Exports()...)
+
+	for _, componentIn := range paths {
+		hasParam := strings.Contains(string(componentIn), spec.TemplateParam)
+
+		if !isTemplate {
+			if hasParam {
+				return fmt.Errorf("path '%s' contain '%s', but component name is not template", componentIn, spec.TemplateParam)
+			}
+
+			continue
+		}
+
+		if !hasParam {
+			if componentIn.IsExclusion() {
+				continue
+			}
+
+			return fmt.Errorf("path '%s' of component template should contain '%s'", componentIn, spec.TemplateParam)
+		}
+
+		for _, segment := range strings.Split(string(componentIn.Pattern()), "/") {
+			if strings.Contains(segment, spec.TemplateParam) && segment != spec.TemplateParam {
+				return fmt.Errorf("'%s' should be whole directory name in path '%s' (like 'modules/%s/**')",
+					spec.TemplateParam,
+					componentIn,
+					spec.TemplateParam,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validatorComponents) localPath(doc spec.Document, componentIn models.Glob) string {
+	return path.Clean(fmt.Sprintf("%s/%s",
+		doc.WorkingDirectory().Value,
+		string(componentIn),
+	))
+}
+
+func anyIncluded(paths []string, included map[string]struct{}) bool {
+	for _, resolvedPath := range paths {
+		if _, ok := included[filepath.Clean(resolvedPath)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -23,7 +23,7 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 	notices := make([]arch.Notice, 0)
 
 	for name, rule := range doc.Dependencies() {
-		if err := v.utils.assertKnownComponent(name); err != nil {
+		if err := v.assertRuleName(name); err != nil {
 			notices = append(notices, arch.Notice{
 				Notice: err,
 				Ref:    rule.Reference,
@@ -44,7 +44,12 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 			})
 		}
 
-		if len(rule.Value.MayDependOn()) == 0 && len(rule.Value.CanUse()) == 0 {
+		hasAllowLists := len(rule.Value.MayDependOn()) > 0 || len(rule.Value.CanUse()) > 0
+		hasDenyLists := len(rule.Value.MayNotDependOn()) > 0 || len(rule.Value.CannotUse()) > 0
+		hasSymbols := len(rule.Value.ForbidSymbols()) > 0 || len(rule.Value.AllowSymbols()) > 0
+		hasCapabilities := rule.Value.Capabilities() != nil
+
+		if !hasAllowLists && !hasDenyLists && !hasCapabilities && !hasSymbols {
 			if rule.Value.AnyProjectDeps().Value {
 				continue
 			}
@@ -62,3 +67,11 @@ func (v *validatorDeps) Validate(doc spec.Document) []arch.Notice {
 
 	return notices
 }
+
+func (v *validatorDeps) assertRuleName(name string) error {
+	if spec.IsRuleGlob(name) {
+		return v.utils.assertComponentGlob(name)
+	}
+
+	return v.utils.assertKnownComponent(name)
+}
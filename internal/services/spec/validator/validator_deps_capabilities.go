@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorDepsCapabilities struct{}
+
+func newValidatorDepsCapabilities() *validatorDepsCapabilities {
+	return &validatorDepsCapabilities{}
+}
+
+func (v *validatorDepsCapabilities) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	knownCapabilities := make(map[string]bool, len(models.CapabilityValues))
+	for _, capability := range models.CapabilityValues {
+		knownCapabilities[capability] = true
+	}
+
+	for name, rule := range doc.Dependencies() {
+		existCapabilities := make(map[string]bool)
+
+		for _, capability := range rule.Value.Capabilities() {
+			if _, ok := existCapabilities[capability.Value]; ok {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("capability '%s' dublicated in '%s' deps", capability.Value, name),
+					Ref:    capability.Reference,
+				})
+			}
+
+			if _, ok := knownCapabilities[capability.Value]; !ok {
+				notices = append(notices, arch.Notice{
+					Notice: fmt.Errorf("unknown capability '%s', expected one of [%s]",
+						capability.Value,
+						strings.Join(models.CapabilityValues, ", "),
+					),
+					Ref: capability.Reference,
+				})
+			}
+
+			existCapabilities[capability.Value] = true
+		}
+	}
+
+	return notices
+}
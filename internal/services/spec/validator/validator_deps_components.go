@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
 	"github.com/fe3dback/go-arch-lint/internal/services/spec"
 )
 
@@ -23,25 +24,58 @@ func (v *validatorDepsComponents) Validate(doc spec.Document) []arch.Notice {
 	notices := make([]arch.Notice, 0)
 
 	for name, rule := range doc.Dependencies() {
-		existComponents := make(map[string]bool)
-
-		for _, componentName := range rule.Value.MayDependOn() {
-			if _, ok := existComponents[componentName.Value]; ok {
-				notices = append(notices, arch.Notice{
-					Notice: fmt.Errorf("component '%s' dublicated in '%s' deps", componentName.Value, name),
-					Ref:    componentName.Reference,
-				})
-			}
+		allowedComponents := make(map[string]bool)
+		deniedComponents := make(map[string]bool)
+
+		notices = append(notices, v.validateList(name, rule.Value.MayDependOn(), allowedComponents)...)
+		notices = append(notices, v.validateList(name, rule.Value.MayNotDependOn(), deniedComponents)...)
 
-			if err := v.utils.assertKnownComponent(componentName.Value); err != nil {
-				notices = append(notices, arch.Notice{
-					Notice: err,
-					Ref:    componentName.Reference,
-				})
+		for _, componentName := range rule.Value.MayNotDependOn() {
+			if _, ok := allowedComponents[componentName.Value]; !ok {
+				continue
 			}
 
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' used in both 'mayDependOn' and 'mayNotDependOn' of '%s' deps (deny takes precedence, likely this is miss configuration)",
+					componentName.Value,
+					name,
+				),
+				Ref: componentName.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorDepsComponents) validateList(
+	name string,
+	list []common.Referable[string],
+	existComponents map[string]bool,
+) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for _, componentName := range list {
+		if _, ok := existComponents[componentName.Value]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("component '%s' dublicated in '%s' deps", componentName.Value, name),
+				Ref:    componentName.Reference,
+			})
+		}
+
+		if componentName.Value == spec.SelfComponent {
 			existComponents[componentName.Value] = true
+			continue
 		}
+
+		if err := v.utils.assertKnownComponent(componentName.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    componentName.Reference,
+			})
+		}
+
+		existComponents[componentName.Value] = true
 	}
 
 	return notices
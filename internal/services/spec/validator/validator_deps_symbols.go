@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/models/common"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorDepsSymbols struct{}
+
+func newValidatorDepsSymbols() *validatorDepsSymbols {
+	return &validatorDepsSymbols{}
+}
+
+func (v *validatorDepsSymbols) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for name, rule := range doc.Dependencies() {
+		forbidden := make(map[string]bool)
+		allowed := make(map[string]bool)
+
+		notices = append(notices, v.validateList(name, rule.Value.ForbidSymbols(), forbidden)...)
+		notices = append(notices, v.validateList(name, rule.Value.AllowSymbols(), allowed)...)
+
+		for _, symbol := range rule.Value.AllowSymbols() {
+			if _, ok := forbidden[symbol.Value]; !ok {
+				continue
+			}
+
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("symbol '%s' used in both 'forbidSymbols' and 'allowSymbols' of '%s' deps (forbid takes precedence, likely this is miss configuration)",
+					symbol.Value,
+					name,
+				),
+				Ref: symbol.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorDepsSymbols) validateList(
+	name string,
+	list []common.Referable[string],
+	existSymbols map[string]bool,
+) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for _, symbol := range list {
+		if _, ok := existSymbols[symbol.Value]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("symbol '%s' dublicated in '%s' deps", symbol.Value, name),
+				Ref:    symbol.Reference,
+			})
+		}
+
+		if !models.SymbolValid(symbol.Value) {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid symbol '%s', expected package import path and identifier (like 'time.Now' or 'github.com/pkg/errors.Wrap')", symbol.Value),
+				Ref:    symbol.Reference,
+			})
+		}
+
+		existSymbols[symbol.Value] = true
+	}
+
+	return notices
+}
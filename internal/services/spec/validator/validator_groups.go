@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models"
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorGroups struct {
+	utils *utils
+}
+
+func newValidatorGroups(
+	utils *utils,
+) *validatorGroups {
+	return &validatorGroups{
+		utils: utils,
+	}
+}
+
+func (v *validatorGroups) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for name, group := range doc.Groups() {
+		if len(group.Value.Components()) == 0 {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("group '%s' should contain at least one component", name),
+				Ref:    group.Reference,
+			})
+		}
+
+		for _, componentName := range group.Value.Components() {
+			if err := v.assertGroupComponent(componentName.Value); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    componentName.Reference,
+				})
+			}
+		}
+
+		api := group.Value.PublicAPI()
+		if api.Value == "" {
+			continue
+		}
+
+		if !group.Value.Isolated().Value {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("'api' of group '%s' is used only with 'isolated=true' (likely this is miss configuration)", name),
+				Ref:    api.Reference,
+			})
+		}
+
+		if _, err := models.Glob(api.Value).Match(""); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("invalid 'api' path '%s': %w", api.Value, err),
+				Ref:    api.Reference,
+			})
+		}
+	}
+
+	return notices
+}
+
+func (v *validatorGroups) assertGroupComponent(name string) error {
+	if spec.IsRuleGlob(name) {
+		return v.utils.assertComponentGlob(name)
+	}
+
+	return v.utils.assertKnownComponent(name)
+}
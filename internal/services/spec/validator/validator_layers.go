@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorLayers struct {
+	utils *utils
+}
+
+func newValidatorLayers(
+	utils *utils,
+) *validatorLayers {
+	return &validatorLayers{
+		utils: utils,
+	}
+}
+
+func (v *validatorLayers) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+	exist := make(map[string]struct{})
+
+	for _, layer := range doc.Layers() {
+		if _, ok := exist[layer.Value]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("layer '%s' dublicated in layers order", layer.Value),
+				Ref:    layer.Reference,
+			})
+		}
+
+		exist[layer.Value] = struct{}{}
+
+		if err := v.assertLayer(doc, layer.Value); err != nil {
+			notices = append(notices, arch.Notice{
+				Notice: err,
+				Ref:    layer.Reference,
+			})
+		}
+	}
+
+	names := make([]string, 0, len(doc.Components()))
+	for name := range doc.Components() {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		layers := make([]string, 0)
+		for _, layer := range doc.Layers() {
+			if spec.LayerMatch(doc, layer.Value, name) {
+				layers = append(layers, layer.Value)
+			}
+		}
+
+		if len(layers) <= 1 {
+			continue
+		}
+
+		notices = append(notices, arch.Notice{
+			Notice: fmt.Errorf("component '%s' matched by several layers: '%s' (component can be only in one layer)",
+				name,
+				strings.Join(layers, "', '"),
+			),
+			Ref: doc.Components()[name].Reference,
+		})
+	}
+
+	return notices
+}
+
+func (v *validatorLayers) assertLayer(doc spec.Document, layer string) error {
+	if _, ok := doc.Groups()[layer]; ok {
+		return nil
+	}
+
+	if spec.IsRuleGlob(layer) {
+		return v.utils.assertComponentGlob(layer)
+	}
+
+	if err := v.utils.assertKnownComponent(layer); err != nil {
+		return fmt.Errorf("unknown component or group '%s'", layer)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/internal/models/arch"
+	"github.com/fe3dback/go-arch-lint/internal/services/spec"
+)
+
+type validatorStdlib struct {
+	utils *utils
+}
+
+func newValidatorStdlib(
+	utils *utils,
+) *validatorStdlib {
+	return &validatorStdlib{
+		utils: utils,
+	}
+}
+
+func (v *validatorStdlib) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for name, stdlib := range doc.Stdlib() {
+		if _, ok := doc.Vendors()[name]; ok {
+			notices = append(notices, arch.Notice{
+				Notice: fmt.Errorf("stdlib name '%s' already used by vendor, names should be unique across 'vendors' and 'stdlib'", name),
+				Ref:    stdlib.Reference,
+			})
+		}
+
+		importPaths := stdlib.Value.ImportPaths()
+		for _, stdlibIn := range importPaths {
+			if !stdlibIn.IsExclusion() {
+				continue
+			}
+
+			if err := v.utils.assertExclusionMatchGlobs(stdlibIn, importPaths); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    stdlib.Reference,
+				})
+			}
+		}
+	}
+
+	return notices
+}
@@ -17,6 +17,25 @@ func newValidatorVendors(
 	}
 }
 
-func (v *validatorVendors) Validate(_ spec.Document) []arch.Notice {
-	return make([]arch.Notice, 0)
+func (v *validatorVendors) Validate(doc spec.Document) []arch.Notice {
+	notices := make([]arch.Notice, 0)
+
+	for _, vendor := range doc.Vendors() {
+		importPaths := vendor.Value.ImportPaths()
+
+		for _, vendorIn := range importPaths {
+			if !vendorIn.IsExclusion() {
+				continue
+			}
+
+			if err := v.utils.assertExclusionMatchGlobs(vendorIn, importPaths); err != nil {
+				notices = append(notices, arch.Notice{
+					Notice: err,
+					Ref:    vendor.Reference,
+				})
+			}
+		}
+	}
+
+	return notices
 }
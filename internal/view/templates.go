@@ -13,12 +13,21 @@ var viewCheck []byte
 //go:embed view_error.gohtml
 var viewError []byte
 
+//go:embed view_fmt.gohtml
+var viewFmt []byte
+
 //go:embed view_graph.gohtml
 var viewGraph []byte
 
+//go:embed view_init.gohtml
+var viewInit []byte
+
 //go:embed view_mapping.gohtml
 var viewMapping []byte
 
+//go:embed view_migrate.gohtml
+var viewMigrate []byte
+
 //go:embed view_schema.gohtml
 var viewSchema []byte
 
@@ -31,8 +40,11 @@ var viewVersion []byte
 var Templates = map[string]string{
 	tpl(models.CmdCheckOut{}):       string(viewCheck),
 	tpl(models.CmdErrorOut{}):       string(viewError),
+	tpl(models.CmdFmtOut{}):         string(viewFmt),
 	tpl(models.CmdGraphOut{}):       string(viewGraph),
+	tpl(models.CmdInitOut{}):        string(viewInit),
 	tpl(models.CmdMappingOut{}):     string(viewMapping),
+	tpl(models.CmdMigrateOut{}):     string(viewMigrate),
 	tpl(models.CmdSchemaOut{}):      string(viewSchema),
 	tpl(models.CmdSelfInspectOut{}): string(viewSelfInspect),
 	tpl(models.CmdVersionOut{}):     string(viewVersion),
@@ -0,0 +1,12 @@
+package di
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_baseline/internal/operations"
+	"github.com/fe3dback/go-arch-lint/test/check/project_baseline/internal/repository"
+)
+
+func Operation() *operations.Operation {
+	return operations.NewOperation(
+		repository.NewMemory(),
+	)
+}
@@ -0,0 +1,9 @@
+package operations
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_baseline/internal/repository"
+)
+
+func NewLegacyOperation() *Operation {
+	return NewOperation(repository.NewMemory())
+}
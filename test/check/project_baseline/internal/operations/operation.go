@@ -0,0 +1,17 @@
+package operations
+
+type (
+	fetcher interface {
+		Fetch()
+	}
+
+	Operation struct {
+		fetcher fetcher
+	}
+)
+
+func NewOperation(fetcher fetcher) *Operation {
+	return &Operation{
+		fetcher: fetcher,
+	}
+}
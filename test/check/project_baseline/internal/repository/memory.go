@@ -0,0 +1,9 @@
+package repository
+
+type Memory struct{}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Fetch() {}
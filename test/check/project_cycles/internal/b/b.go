@@ -0,0 +1,9 @@
+package b
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_cycles/internal/c"
+)
+
+func B() {
+	c.C()
+}
@@ -0,0 +1,9 @@
+package c
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_cycles/internal/a/model"
+)
+
+func C() {
+	_ = model.Model{}
+}
@@ -0,0 +1,25 @@
+package di
+
+import (
+	"context"
+
+	"github.com/fe3dback/go-arch-lint/test/check/project_deepscan/internal/operations"
+	"github.com/fe3dback/go-arch-lint/test/check/project_deepscan/internal/repository"
+)
+
+func Build() {
+	memory := &repository.Memory{}
+
+	// func values
+	_ = operations.NewOperation(memory.Save)
+	_ = operations.NewScheduler(repository.Flush)
+
+	// type param instantiations
+	_ = operations.NewProcessor(memory)
+	_ = operations.NewProcessor[*repository.Memory](memory)
+
+	// anonymous func is defined by injector, not by repository
+	_ = operations.NewOperation(func(ctx context.Context) error {
+		return nil
+	})
+}
@@ -0,0 +1,35 @@
+package operations
+
+import "context"
+
+type (
+	Saver interface {
+		Save(ctx context.Context) error
+	}
+
+	Runner func(ctx context.Context) error
+
+	Operation struct {
+		save func(ctx context.Context) error
+	}
+
+	Scheduler struct {
+		run Runner
+	}
+
+	Processor[S Saver] struct {
+		saver S
+	}
+)
+
+func NewOperation(save func(ctx context.Context) error) *Operation {
+	return &Operation{save: save}
+}
+
+func NewScheduler(run Runner) *Scheduler {
+	return &Scheduler{run: run}
+}
+
+func NewProcessor[S Saver](saver S) *Processor[S] {
+	return &Processor[S]{saver: saver}
+}
@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/check/project_deps_glob
+
+go 1.18
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/modules/billing/invoice"
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/modules/orders"
+)
+
+func Run() {
+	invoice.Create()
+	orders.Run()
+}
@@ -0,0 +1,9 @@
+package billing
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/shared"
+)
+
+func Run() {
+	shared.Log("billing")
+}
@@ -0,0 +1,9 @@
+package invoice
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/modules/billing"
+)
+
+func Create() {
+	billing.Run()
+}
@@ -0,0 +1,11 @@
+package orders
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/modules/users"
+	"github.com/fe3dback/go-arch-lint/test/check/project_deps_glob/internal/shared"
+)
+
+func Run() {
+	shared.Log("orders")
+	users.Run()
+}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_exports/internal/services/billing"
+	"github.com/fe3dback/go-arch-lint/test/check/project_exports/internal/services/billing/internal/calc"
+)
+
+func Run() {
+	billing.Charge(1, 2)
+	calc.Total(3)
+}
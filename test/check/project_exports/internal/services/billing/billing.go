@@ -0,0 +1,9 @@
+package billing
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_exports/internal/services/billing/internal/calc"
+)
+
+func Charge(prices ...int) int {
+	return calc.Total(prices...)
+}
@@ -0,0 +1,10 @@
+package calc
+
+func Total(prices ...int) int {
+	total := 0
+	for _, price := range prices {
+		total += price
+	}
+
+	return total
+}
@@ -0,0 +1,9 @@
+package api
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_groups/internal/modules/billing"
+)
+
+func Run() {
+	billing.Run()
+}
@@ -0,0 +1,11 @@
+package orders
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_groups/internal/modules/billing"
+	usersAPI "github.com/fe3dback/go-arch-lint/test/check/project_groups/internal/modules/users/api"
+)
+
+func Run() {
+	usersAPI.Run()
+	billing.Run()
+}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_layers/internal/domain"
+	"github.com/fe3dback/go-arch-lint/test/check/project_layers/internal/infra/db"
+)
+
+func Run() {
+	db.Exec("migrate")
+	domain.User{Name: "admin"}.Save()
+}
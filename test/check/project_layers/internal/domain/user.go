@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_layers/internal/infra/db"
+)
+
+type User struct {
+	Name string
+}
+
+func (u User) Save() {
+	db.Exec("insert")
+}
@@ -0,0 +1,3 @@
+package db
+
+func Exec(_ string) {}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_leaks/internal/handlers"
+	"github.com/fe3dback/go-arch-lint/test/check/project_leaks/internal/repository/pg"
+	"github.com/fe3dback/go-arch-lint/test/check/project_leaks/internal/services/user"
+)
+
+func Run() *handlers.Handler {
+	return handlers.NewHandler(user.NewService(&pg.Repository{}))
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_leaks/internal/services/user"
+)
+
+type Handler struct {
+	service *user.Service
+}
+
+func NewHandler(service *user.Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) Name(id int) string {
+	found, err := h.service.Find(id)
+	if err != nil {
+		return ""
+	}
+
+	return found.Name
+}
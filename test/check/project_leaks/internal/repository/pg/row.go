@@ -0,0 +1,12 @@
+package pg
+
+type Row struct {
+	ID   int
+	Name string
+}
+
+type Repository struct{}
+
+func (r *Repository) Find(id int) (*Row, error) {
+	return &Row{ID: id}, nil
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_leaks/internal/repository/pg"
+)
+
+// Record leaks infrastructure type to handlers
+type Record = pg.Row
+
+type User struct {
+	ID   int
+	Name string
+}
+
+type Service struct {
+	LastRow *pg.Row
+
+	repository *pg.Repository
+}
+
+func NewService(repository *pg.Repository) *Service {
+	return &Service{repository: repository}
+}
+
+func (s *Service) Find(id int) (User, error) {
+	row, err := s.repository.Find(id)
+	if err != nil {
+		return User{}, err
+	}
+
+	return User{ID: row.ID, Name: row.Name}, nil
+}
+
+func (s *Service) FindRows(ids []int) (map[int]*pg.Row, error) {
+	rows := make(map[int]*pg.Row, len(ids))
+	for _, id := range ids {
+		row, err := s.repository.Find(id)
+		if err != nil {
+			return nil, err
+		}
+
+		rows[id] = row
+	}
+
+	return rows, nil
+}
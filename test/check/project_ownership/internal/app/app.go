@@ -0,0 +1,15 @@
+package app
+
+import (
+	"time"
+
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/contracts"
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/repository/pg"
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/services/order"
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/services/user"
+)
+
+func Run() {
+	_ = user.NewService(&pg.Users{}, contracts.SystemClock{})
+	_ = order.NewService(&pg.Users{}, time.Second)
+}
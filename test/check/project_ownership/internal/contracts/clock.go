@@ -0,0 +1,13 @@
+package contracts
+
+import "time"
+
+type Clock interface {
+	Unix() int64
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Unix() int64 {
+	return time.Now().Unix()
+}
@@ -0,0 +1,12 @@
+package pg
+
+// UserFinder is declared by provider, consumers depend on it
+type UserFinder interface {
+	FindName(id int) string
+}
+
+type Users struct{}
+
+func (u *Users) FindName(id int) string {
+	return "admin"
+}
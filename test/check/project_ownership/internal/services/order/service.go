@@ -0,0 +1,19 @@
+package order
+
+import (
+	"fmt"
+)
+
+// finder is owned by consumer
+type finder interface {
+	FindName(id int) string
+}
+
+type Service struct {
+	finder finder
+	out    fmt.Stringer
+}
+
+func NewService(finder finder, out fmt.Stringer) *Service {
+	return &Service{finder: finder, out: out}
+}
@@ -0,0 +1,15 @@
+package user
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/contracts"
+	"github.com/fe3dback/go-arch-lint/test/check/project_ownership/internal/repository/pg"
+)
+
+type Service struct {
+	finder pg.UserFinder
+	clock  contracts.Clock
+}
+
+func NewService(finder pg.UserFinder, clock contracts.Clock) *Service {
+	return &Service{finder: finder, clock: clock}
+}
@@ -0,0 +1,5 @@
+package grpc
+
+type Server struct{}
+
+func (s *Server) Register(_ string) {}
@@ -0,0 +1,9 @@
+package user
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_split/internal/model"
+)
+
+func Find(id int) model.User {
+	return model.User{ID: id}
+}
@@ -0,0 +1,9 @@
+package user
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_split/internal/grpc"
+)
+
+func RegisterGRPC(server *grpc.Server) {
+	server.Register("user")
+}
@@ -0,0 +1,15 @@
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+	"unsafe"
+)
+
+func App() {
+	fmt.Println("common stdlib - allowed")
+	_ = http.StatusOK            // allowed by canUse
+	_ = exec.Command("ls").Run() // allowed by canUse
+	_ = unsafe.Sizeof(0)         // denied by cannotUse
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+)
+
+func Domain() {
+	fmt.Println("common stdlib - allowed")
+	_ = http.StatusOK            // not allowed
+	_ = exec.Command("ls").Run() // not allowed
+}
@@ -0,0 +1,13 @@
+package infra
+
+import (
+	"net/http"
+	"os"
+	"reflect"
+)
+
+func Infra() {
+	_ = http.StatusOK             // allowed network capability
+	_, _ = os.ReadFile("/tmp/go") // allowed filesystem capability
+	_ = reflect.TypeOf(0)         // reflect capability not allowed
+}
@@ -0,0 +1,9 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/infra" //go-arch-lint:ignore not required, dependency is allowed
+)
+
+func App() {
+	infra.Infra()
+}
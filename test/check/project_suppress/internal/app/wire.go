@@ -0,0 +1,10 @@
+package app
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/repo" //go-arch-lint:ignore service will get own repository interface
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/service"
+)
+
+func Wire() *service.Service {
+	return service.NewService(&repo.Repository{})
+}
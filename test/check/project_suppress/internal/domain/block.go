@@ -0,0 +1,13 @@
+package domain
+
+//go-arch-lint:ignore temporary, see TODO in Block
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/app"
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/infra"
+)
+
+func Block() {
+	// TODO: remove app and infra usage
+	app.App()
+	infra.Infra()
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"fmt"
+
+	//go-arch-lint:ignore infra usage will be replaced by interface
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/infra"
+)
+
+func Domain() {
+	fmt.Println("domain")
+	infra.Infra()
+}
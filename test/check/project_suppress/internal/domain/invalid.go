@@ -0,0 +1,9 @@
+package domain
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/app" //go-arch-lint:ignore
+)
+
+func Invalid() {
+	app.App()
+}
@@ -0,0 +1,13 @@
+//go-arch-lint:ignore legacy code, will be moved to app
+
+package domain
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/app"
+	"github.com/fe3dback/go-arch-lint/test/check/project_suppress/internal/infra"
+)
+
+func Legacy() {
+	app.App()
+	infra.Infra()
+}
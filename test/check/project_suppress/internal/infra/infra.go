@@ -0,0 +1,3 @@
+package infra
+
+func Infra() {}
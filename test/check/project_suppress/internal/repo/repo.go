@@ -0,0 +1,5 @@
+package repo
+
+type Repository struct{}
+
+func (r *Repository) Save(_ string) {}
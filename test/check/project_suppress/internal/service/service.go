@@ -0,0 +1,13 @@
+package service
+
+type Repository interface {
+	Save(name string)
+}
+
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{repository: repository}
+}
@@ -0,0 +1,13 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/test/check/project_symbols/internal/clock/v1"
+	"github.com/fe3dback/go-arch-lint/test/check/project_symbols/internal/domain"
+)
+
+func Run() {
+	user := domain.NewUser("admin")
+	fmt.Println(user.Age(v1.Now()))
+}
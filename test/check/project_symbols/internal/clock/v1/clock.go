@@ -0,0 +1,7 @@
+package v1
+
+import "time"
+
+func Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "fmt"
+
+func ExampleNewUser() {
+	fmt.Println(NewUser("admin").Name)
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type User struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+func NewUser(name string) User {
+	fmt.Println("new user", name)
+
+	return User{
+		Name:      strings.ToUpper(name),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (u User) Slug() string {
+	return strings.ToLower(u.Name)
+}
+
+func (u User) Age(now time.Time) time.Duration {
+	return now.Sub(u.CreatedAt)
+}
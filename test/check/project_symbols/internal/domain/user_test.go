@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUser_Age(t *testing.T) {
+	user := NewUser("admin")
+
+	if user.Age(time.Now()) < 0 {
+		t.Fail()
+	}
+}
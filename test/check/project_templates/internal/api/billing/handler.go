@@ -0,0 +1,9 @@
+package billing
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_templates/internal/modules/billing"
+)
+
+func Handle() {
+	billing.Run()
+}
@@ -0,0 +1,9 @@
+package users
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_templates/internal/modules/users"
+)
+
+func Handle() {
+	users.Run()
+}
@@ -0,0 +1,11 @@
+package app
+
+import (
+	billingAPI "github.com/fe3dback/go-arch-lint/test/check/project_templates/internal/api/billing"
+	usersAPI "github.com/fe3dback/go-arch-lint/test/check/project_templates/internal/api/users"
+)
+
+func Start() {
+	billingAPI.Handle()
+	usersAPI.Handle()
+}
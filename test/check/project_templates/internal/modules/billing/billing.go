@@ -0,0 +1,9 @@
+package billing
+
+import (
+	"github.com/fe3dback/go-arch-lint/test/check/project_templates/internal/shared"
+)
+
+func Run() {
+	shared.Log("billing")
+}
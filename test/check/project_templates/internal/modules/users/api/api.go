@@ -0,0 +1,5 @@
+package api
+
+type User struct {
+	Name string
+}
@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/fmt/project
+
+go 1.20
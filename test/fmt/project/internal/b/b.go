@@ -0,0 +1,3 @@
+package b
+
+import _ "github.com/fe3dback/go-arch-lint/test/fmt/project/internal/a"
@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/migrate/project
+
+go 1.20
@@ -0,0 +1,3 @@
+module github.com/fe3dback/go-arch-lint/test/selfInspect/project_unused
+
+go 1.18
@@ -0,0 +1,12 @@
+package a
+
+import (
+	"github.com/example/used"
+
+	"github.com/fe3dback/go-arch-lint/test/selfInspect/project_unused/internal/b"
+)
+
+func A() {
+	b.B()
+	used.Used()
+}
@@ -0,0 +1,11 @@
+package b
+
+import (
+	"fmt"
+
+	"github.com/fe3dback/go-arch-lint/test/selfInspect/project_unused/internal/models"
+)
+
+func B() {
+	fmt.Println(models.Model{})
+}